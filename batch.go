@@ -0,0 +1,67 @@
+package holdem
+
+// EvaluateBatch scores every hand into out, which must be at least as long
+// as hands. Each hand may have any number of cards, as with Value.
+func EvaluateBatch(hands []Hand, out []HandValue) {
+	for i, h := range hands {
+		out[i] = h.Value()
+	}
+}
+
+// EvaluateBatch7 is like EvaluateBatch for hands of exactly seven cards,
+// which it scores without counting them first.
+func EvaluateBatch7(hands []Hand, out []HandValue) {
+	batch7(hands, 0, out)
+}
+
+// batch7 scores each hand with board, seven cards in all, into out.
+func batch7(hands []Hand, board Hand, out []HandValue) {
+	if perfectHash {
+		hashOnce.Do(buildHashTables)
+		for i, h := range hands {
+			out[i] = (h | board).hashValue()
+		}
+		return
+	}
+
+	for i, h := range hands {
+		out[i] = (h | board).ValueCards(7)
+	}
+}
+
+// Board is a fixed set of community cards, combined once so that many
+// pairs of hole cards can be scored against it.
+type Board struct {
+	mask  Hand
+	cards int
+}
+
+// NewBoard combines the community cards for scoring hands against them.
+func NewBoard(cards []Card) Board {
+	b := Board{mask: NewHandCards(cards)}
+	b.cards = countBits(uint64(b.mask))
+
+	return b
+}
+
+// Value scores two hole cards, none of them on the board, with the board.
+func (b Board) Value(hole Hand) HandValue {
+	if b.cards == 5 {
+		return value7(hole | b.mask)
+	}
+
+	return (hole | b.mask).ValueCards(b.cards + 2)
+}
+
+// EvaluateBatch scores every pair of hole cards in holes with the board
+// into out, which must be at least as long as holes.
+func (b Board) EvaluateBatch(holes []Hand, out []HandValue) {
+	if b.cards == 5 {
+		batch7(holes, b.mask, out)
+		return
+	}
+
+	for i, h := range holes {
+		out[i] = (h | b.mask).ValueCards(b.cards + 2)
+	}
+}
@@ -0,0 +1,120 @@
+package holdem
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestEvaluateBatch(t *testing.T) {
+	t.Parallel()
+
+	deck := NewDeck(rand.NewSource(25))
+	var hands, sevens []Hand
+	for n := 0; n < 1000; n++ {
+		deck.Reset()
+		deck.Shuffle()
+		hands = append(hands, NewHandCards(deck.Deal(5+n%3)))
+
+		deck.Reset()
+		deck.Shuffle()
+		sevens = append(sevens, NewHandCards(deck.Deal(7)))
+	}
+
+	out := make([]HandValue, len(hands)+1)
+	EvaluateBatch(hands, out)
+	for i, h := range hands {
+		if exp := h.Value(); out[i] != exp {
+			t.Fatalf("%v: expected: %v, got: %v", h.Cards(), exp, out[i])
+		}
+	}
+
+	EvaluateBatch7(sevens, out)
+	for i, h := range sevens {
+		if exp := h.ValueCards(7); out[i] != exp {
+			t.Fatalf("%v: expected: %v, got: %v", h.Cards(), exp, out[i])
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected EvaluateBatch to panic when out is too short")
+		}
+	}()
+	EvaluateBatch(hands, out[:10])
+}
+
+func TestBoard(t *testing.T) {
+	t.Parallel()
+
+	holes := []Hand{
+		NewHandStr("ah kh"),
+		NewHandStr("2c 2d"),
+		NewHandStr("7s 8s"),
+		NewHandStr("qd jc"),
+	}
+
+	for _, str := range []string{"", "qh jh 2h", "qh jh 2h 9s", "qh jh 2h 9s 10c"} {
+		cards := mustParseCards(t, str)
+		board := NewBoard(cards)
+
+		out := make([]HandValue, len(holes))
+		board.EvaluateBatch(holes, out)
+		for i, h := range holes {
+			exp := NewHandCards(h.Cards(), cards).Value()
+			if got := board.Value(h); got != exp {
+				t.Errorf("%v on %q: expected: %v, got: %v", h.Cards(), str, exp, got)
+			}
+			if out[i] != exp {
+				t.Errorf("%v on %q: expected: %v, got: %v", h.Cards(), str, exp, out[i])
+			}
+		}
+	}
+}
+
+// The benchmarks below score one hand per op.
+
+func BenchmarkValue(b *testing.B) {
+	hands := sevenCardHands(1 << 12)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hands[i&(len(hands)-1)].Value()
+	}
+}
+
+func benchmarkBatch(b *testing.B, hands []Hand, eval func(hands []Hand, out []HandValue)) {
+	out := make([]HandValue, len(hands))
+	eval(hands[:1], out)
+	b.ResetTimer()
+	for n := b.N; n > 0; n -= len(hands) {
+		k := len(hands)
+		if n < k {
+			k = n
+		}
+		eval(hands[:k], out)
+	}
+}
+
+func BenchmarkEvaluateBatch(b *testing.B) {
+	benchmarkBatch(b, sevenCardHands(1<<12), EvaluateBatch)
+}
+
+func BenchmarkEvaluateBatch7(b *testing.B) {
+	benchmarkBatch(b, sevenCardHands(1<<12), EvaluateBatch7)
+}
+
+func BenchmarkBoard_EvaluateBatch(b *testing.B) {
+	deck := NewDeck(rand.NewSource(1))
+	deck.Shuffle()
+	board := NewBoard(deck.Deal(5))
+
+	// Every pair of hole cards left in the deck.
+	left := deck.Deal(deck.Remaining())
+	var holes []Hand
+	for i := range left {
+		for j := i + 1; j < len(left); j++ {
+			holes = append(holes, NewHandCards([]Card{left[i], left[j]}))
+		}
+	}
+
+	benchmarkBatch(b, holes, board.EvaluateBatch)
+}
@@ -0,0 +1,335 @@
+package holdem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ActionType is the kind of move a player makes when it is their turn.
+type ActionType int
+
+const (
+	Fold ActionType = iota
+	Check
+	Call
+	Bet
+	Raise
+)
+
+// minimumBet is the smallest opening bet of a game without blinds.
+const minimumBet = 1
+
+// Errors returned by Act and the action shorthands.
+var (
+	ErrUnknownPlayer = errors.New("holdem: no such player")
+	ErrNotYourTurn   = errors.New("holdem: not your turn")
+	ErrIllegalAction = errors.New("holdem: illegal action")
+)
+
+// Action is a move by a player. Amount is only used by Bet and Raise, and is
+// the total the player's bet for the street is raised to, not the increase.
+type Action struct {
+	Type   ActionType
+	Amount uint32
+}
+
+// LegalAction is a move the player to act is allowed to make. Min and Max
+// bound the total street bet the action leaves the player with; for Call
+// they are both the amount called to, and for Fold and Check both zero.
+type LegalAction struct {
+	Type ActionType
+	Min  uint32
+	Max  uint32
+}
+
+func (t ActionType) String() string {
+	switch t {
+	case Fold:
+		return "fold"
+	case Check:
+		return "check"
+	case Call:
+		return "call"
+	case Bet:
+		return "bet"
+	case Raise:
+		return "raise"
+	}
+
+	return fmt.Sprintf("ActionType(%d)", int(t))
+}
+
+func (a Action) String() string {
+	switch a.Type {
+	case Bet, Raise:
+		return fmt.Sprintf("%v to %d", a.Type, a.Amount)
+	}
+
+	return a.Type.String()
+}
+
+// ToAct returns the name of the player whose turn it is, or "" if no
+// betting round is in progress.
+func (g *Game) ToAct() string {
+	if g.actor < 0 || g.actor >= len(g.players) {
+		return ""
+	}
+
+	return g.players[g.actor].Name
+}
+
+// LegalActions returns the moves player may make. It returns nil if it is
+// not player's turn.
+func (g *Game) LegalActions(player string) []LegalAction {
+	if player == "" || player != g.ToAct() {
+		return nil
+	}
+
+	p := g.players[g.actor]
+	stack := p.Bet + p.Balance
+	legal := []LegalAction{{Type: Fold}}
+
+	if p.Bet >= g.currentBet {
+		legal = append(legal, LegalAction{Type: Check})
+	} else {
+		to := min32(g.currentBet, stack)
+		legal = append(legal, LegalAction{Call, to, to})
+	}
+
+	// Having acted already, a player only gets the turn again after an
+	// all-in too small to be a full raise, which does not reopen the betting.
+	if stack <= g.currentBet || p.acted {
+		return legal
+	}
+
+	lo, hi, ok := g.structure.RaiseLimits(g.betState(p))
+	if !ok {
+		return legal
+	}
+
+	kind := Raise
+	if g.currentBet == 0 {
+		kind = Bet
+	}
+
+	return append(legal, LegalAction{kind, min32(lo, stack), min32(hi, stack)})
+}
+
+// Act makes player take action a. It returns an error wrapping
+// ErrUnknownPlayer, ErrNotYourTurn or ErrIllegalAction if the move cannot be
+// made, in which case the game is left unchanged.
+func (g *Game) Act(player string, a Action) error {
+	p, _ := g.player(player)
+	if p == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownPlayer, player)
+	}
+	if player != g.ToAct() {
+		if g.ToAct() == "" {
+			return fmt.Errorf("%w: %s, no betting in progress", ErrNotYourTurn, player)
+		}
+		return fmt.Errorf("%w: %s, waiting for %s", ErrNotYourTurn, player, g.ToAct())
+	}
+
+	var legal LegalAction
+	found := false
+	for _, l := range g.LegalActions(player) {
+		if l.Type == a.Type {
+			legal, found = l, true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: %s cannot %v facing a bet of %d", ErrIllegalAction, player, a.Type, g.currentBet)
+	}
+
+	switch a.Type {
+	case Fold:
+		p.Status = Folded
+	case Check:
+	case Call:
+		g.putIn(p, legal.Max-p.Bet)
+	case Bet, Raise:
+		if a.Amount < legal.Min || a.Amount > legal.Max {
+			return fmt.Errorf("%w: %s cannot %v to %d, must be between %d and %d",
+				ErrIllegalAction, player, a.Type, a.Amount, legal.Min, legal.Max)
+		}
+
+		// Only a full raise reopens the betting to those who have acted.
+		if raise := a.Amount - g.currentBet; raise >= g.minRaise {
+			g.minRaise = raise
+			g.raises++
+
+			for _, o := range g.players {
+				o.acted = false
+			}
+		}
+		g.currentBet = a.Amount
+		g.putIn(p, a.Amount-p.Bet)
+	}
+
+	p.acted = true
+	g.nextActor()
+	g.emit(ActionTaken{player, a, g.Pot()})
+
+	return nil
+}
+
+// Raise raises the current bet by bet, or opens the betting with it.
+func (g *Game) Raise(player string, bet uint32) error {
+	if g.currentBet == 0 {
+		return g.Act(player, Action{Bet, bet})
+	}
+
+	return g.Act(player, Action{Raise, g.currentBet + bet})
+}
+
+// Check passes the action without betting.
+func (g *Game) Check(player string) error {
+	return g.Act(player, Action{Type: Check})
+}
+
+// Call matches the current bet, or goes all in if player cannot afford it.
+func (g *Game) Call(player string) error {
+	return g.Act(player, Action{Type: Call})
+}
+
+// Fold gives up the hand.
+func (g *Game) Fold(player string) error {
+	return g.Act(player, Action{Type: Fold})
+}
+
+// BetTimeout makes player check, or fold if they cannot, if it is still
+// their turn. It is meant for games driven by calling Act; Play keeps its
+// own clock.
+func (g *Game) BetTimeout(player string) {
+	if player == g.ToAct() {
+		g.checkOrFold(player)
+	}
+}
+
+func (g *Game) player(name string) (*Player, int) {
+	for i, p := range g.players {
+		if p.Name == name {
+			return p, i
+		}
+	}
+
+	return nil, -1
+}
+
+func (g *Game) putIn(p *Player, amount uint32) {
+	p.Balance -= amount
+	p.Bet += amount
+	p.Total += amount
+
+	if p.Balance == 0 {
+		p.Status = AllIn
+	}
+}
+
+// startBets begins a betting round with the player in seat first, or the
+// next one after them who can still bet.
+func (g *Game) startBets(first int) {
+	g.resetBets()
+
+	g.actor = first - 1
+	g.nextActor()
+}
+
+func (g *Game) resetBets() {
+	g.currentBet = 0
+	g.minRaise = g.minBet()
+	g.raises = 0
+
+	for _, p := range g.players {
+		p.Bet = 0
+		p.acted = false
+	}
+}
+
+// nextActor passes the turn to the next player who still has to act, and
+// ends the betting round if there is none.
+func (g *Game) nextActor() {
+	if g.inHand() < 2 {
+		g.actor = -1
+		return
+	}
+
+	for i := 1; i <= len(g.players); i++ {
+		k := (g.actor + i) % len(g.players)
+		p := g.players[k]
+
+		if p.Status != Active || (p.acted && p.Bet == g.currentBet) {
+			continue
+		}
+
+		// Nobody is left to bet against someone who has matched the bet.
+		if p.Bet == g.currentBet && g.canAct() < 2 {
+			continue
+		}
+
+		g.actor = k
+		return
+	}
+
+	g.actor = -1
+}
+
+// inHand counts the players who have not folded.
+func (g *Game) inHand() int {
+	n := 0
+	for _, p := range g.players {
+		if p.Status != Folded {
+			n++
+		}
+	}
+
+	return n
+}
+
+// canAct counts the players who can still bet.
+func (g *Game) canAct() int {
+	n := 0
+	for _, p := range g.players {
+		if p.Status == Active {
+			n++
+		}
+	}
+
+	return n
+}
+
+// doBets asks the decider for actions until the betting round is over. A
+// player who makes an illegal move or runs out of time checks, or folds if
+// they cannot.
+func (g *Game) doBets(ctx context.Context) {
+	for g.actor >= 0 {
+		name := g.ToAct()
+		if g.decider == nil {
+			g.checkOrFold(name)
+			continue
+		}
+
+		a, ok := g.decide(ctx, name)
+		if !ok || g.Act(name, a) != nil {
+			g.checkOrFold(name)
+		}
+	}
+}
+
+func (g *Game) checkOrFold(player string) error {
+	if err := g.Check(player); err == nil {
+		return nil
+	}
+
+	return g.Fold(player)
+}
+
+func min32(a, b uint32) uint32 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
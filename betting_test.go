@@ -0,0 +1,144 @@
+package holdem
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newBettingGame(names ...string) *Game {
+	game := New()
+	for _, n := range names {
+		game.AddPlayer(n)
+	}
+	game.newRound()
+	game.startBets(0)
+
+	return &game
+}
+
+func TestLegalActions(t *testing.T) {
+	game := newBettingGame("A", "B", "C")
+
+	assert.Nil(t, game.LegalActions("B"))
+	assert.Equal(t, []LegalAction{
+		{Type: Fold},
+		{Type: Check},
+		{Bet, 1, 100},
+	}, game.LegalActions("A"))
+
+	assert.NoError(t, game.Act("A", Action{Bet, 10}))
+	assert.Equal(t, []LegalAction{
+		{Type: Fold},
+		{Call, 10, 10},
+		{Raise, 20, 100},
+	}, game.LegalActions("B"))
+}
+
+func TestAct_Errors(t *testing.T) {
+	game := newBettingGame("A", "B", "C")
+
+	err := game.Check("B")
+	assert.True(t, errors.Is(err, ErrNotYourTurn), err)
+
+	err = game.Check("D")
+	assert.True(t, errors.Is(err, ErrUnknownPlayer), err)
+
+	err = game.Call("A")
+	assert.True(t, errors.Is(err, ErrIllegalAction), err)
+
+	assert.NoError(t, game.Raise("A", 10))
+
+	err = game.Check("B")
+	assert.True(t, errors.Is(err, ErrIllegalAction), err)
+
+	err = game.Act("B", Action{Raise, 15})
+	assert.True(t, errors.Is(err, ErrIllegalAction), err)
+
+	err = game.Act("B", Action{Raise, 101})
+	assert.True(t, errors.Is(err, ErrIllegalAction), err)
+
+	assert.Equal(t, "B", game.ToAct())
+	assert.Equal(t, uint32(100), game.players[1].Balance)
+}
+
+func TestBettingRound(t *testing.T) {
+	game := newBettingGame("A", "B", "C")
+
+	assert.NoError(t, game.Act("A", Action{Bet, 10}))
+	assert.NoError(t, game.Act("B", Action{Raise, 30}))
+	assert.NoError(t, game.Fold("C"))
+	assert.Equal(t, "A", game.ToAct())
+	assert.NoError(t, game.Call("A"))
+
+	assert.Equal(t, "", game.ToAct())
+	assert.Equal(t, uint32(60), game.Pot())
+
+	a, b, c := game.players[0], game.players[1], game.players[2]
+	assert.Equal(t, uint32(70), a.Balance)
+	assert.Equal(t, uint32(30), a.Total)
+	assert.Equal(t, uint32(70), b.Balance)
+	assert.Equal(t, uint32(100), c.Balance)
+	assert.Equal(t, Folded, c.Status)
+
+	err := game.Check("A")
+	assert.True(t, errors.Is(err, ErrNotYourTurn), err)
+
+	game.startBets(0)
+	assert.Equal(t, "A", game.ToAct())
+	assert.NoError(t, game.Check("A"))
+	assert.NoError(t, game.Check("B"))
+	assert.Equal(t, "", game.ToAct())
+}
+
+func TestBettingAllIn(t *testing.T) {
+	game := newBettingGame("A", "B", "C")
+	game.players[0].Balance = 5
+
+	assert.NoError(t, game.Check("A"))
+	assert.NoError(t, game.Raise("B", 20))
+	assert.NoError(t, game.Call("C"))
+
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 5, 5}}, game.LegalActions("A"))
+	assert.NoError(t, game.Call("A"))
+
+	a := game.players[0]
+	assert.Equal(t, AllIn, a.Status)
+	assert.Equal(t, uint32(0), a.Balance)
+	assert.Equal(t, uint32(5), a.Bet)
+	assert.Equal(t, "", game.ToAct())
+
+	// An all in player is skipped on later streets.
+	game.startBets(0)
+	assert.Equal(t, "B", game.ToAct())
+}
+
+func TestBettingEndsOnFolds(t *testing.T) {
+	game := newBettingGame("A", "B", "C")
+
+	assert.NoError(t, game.Raise("A", 10))
+	assert.NoError(t, game.Fold("B"))
+	assert.NoError(t, game.Fold("C"))
+
+	assert.Equal(t, "", game.ToAct())
+	assert.Equal(t, 1, game.inHand())
+}
+
+func TestDoBets(t *testing.T) {
+	game := newBettingGame("A", "B")
+	var order []string
+
+	game.decider = DeciderFunc(func(ctx context.Context, g *Game, name string, legal []LegalAction) Action {
+		order = append(order, name)
+		return Action{Type: Call}
+	})
+	game.startBets(0)
+	game.doBets(context.Background())
+
+	// Calling when there is nothing to call is illegal, so both check.
+	assert.Equal(t, []string{"A", "B"}, order)
+	assert.Equal(t, "", game.ToAct())
+	assert.Equal(t, uint32(0), game.Pot())
+}
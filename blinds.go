@@ -0,0 +1,156 @@
+package holdem
+
+// WithBlinds makes the two players after the button post small and big
+// blinds every hand. The big blind is also the smallest bet.
+func WithBlinds(small, big uint32) Option {
+	return func(g *Game) {
+		g.smallBlind = small
+		g.bigBlind = big
+	}
+}
+
+// WithAnte makes every player post an ante before the cards are dealt.
+func WithAnte(ante uint32) Option {
+	return func(g *Game) {
+		g.ante = ante
+		g.bigBlindAnte = false
+	}
+}
+
+// WithBigBlindAnte makes the big blind post a single ante for the whole
+// table, after their blind.
+func WithBigBlindAnte(ante uint32) Option {
+	return func(g *Game) {
+		g.ante = ante
+		g.bigBlindAnte = true
+	}
+}
+
+// WithStraddle makes the player after the big blind post a straddle of
+// twice the big blind each hand, which they act behind. It is skipped when
+// playing heads up.
+func WithStraddle() Option {
+	return func(g *Game) {
+		g.straddle = true
+	}
+}
+
+// Button returns the name of the player on the button, or "" before the
+// first hand.
+func (g *Game) Button() string {
+	if g.button < 0 || g.button >= len(g.players) {
+		return ""
+	}
+
+	return g.players[g.button].Name
+}
+
+// next returns the seat after seat i.
+func (g *Game) next(i int) int {
+	return (i + 1) % len(g.players)
+}
+
+// seatOrder returns the players starting with the one after the button.
+func (g *Game) seatOrder() []*Player {
+	if len(g.players) == 0 {
+		return nil
+	}
+
+	order := make([]*Player, 0, len(g.players))
+	for i, k := 0, g.next(g.button); i < len(g.players); i, k = i+1, g.next(k) {
+		order = append(order, g.players[k])
+	}
+
+	return order
+}
+
+// removePlayer takes the player in seat i off the table. The button stays
+// behind, so that it moves on to the player who sat after them.
+func (g *Game) removePlayer(i int) {
+	g.players = append(g.players[:i], g.players[i+1:]...)
+
+	if i <= g.button {
+		g.button--
+	}
+}
+
+// moveButton passes the button on to the next player.
+func (g *Game) moveButton() {
+	if len(g.players) == 0 {
+		g.button = -1
+		return
+	}
+
+	g.button = g.next(g.button)
+}
+
+// blindSeats returns the seats of the small and big blind. Heads up, the
+// button posts the small blind.
+func (g *Game) blindSeats() (small, big int) {
+	small = g.next(g.button)
+	if len(g.players) == 2 {
+		small = g.button
+	}
+
+	return small, g.next(small)
+}
+
+func (g *Game) postAntes() {
+	if g.ante == 0 || g.bigBlindAnte {
+		return
+	}
+
+	for _, p := range g.seatOrder() {
+		g.postDead(p, g.ante)
+	}
+}
+
+// startPreFlop posts the blinds and straddle and begins the first betting
+// round with the player after them.
+func (g *Game) startPreFlop() {
+	small, big := g.blindSeats()
+	g.street = PreFlop
+	g.resetBets()
+
+	g.putIn(g.players[small], min32(g.smallBlind, g.players[small].Balance))
+	g.putIn(g.players[big], min32(g.bigBlind, g.players[big].Balance))
+	g.currentBet = g.bigBlind
+	g.raises = 1
+
+	if g.straddle && len(g.players) > 2 {
+		s := g.next(big)
+		g.putIn(g.players[s], min32(2*g.bigBlind, g.players[s].Balance))
+		g.currentBet = 2 * g.bigBlind
+		g.minRaise = 2 * g.bigBlind
+		g.raises = 2
+		g.actor = s
+	} else {
+		g.actor = big
+	}
+
+	if g.bigBlindAnte {
+		g.postDead(g.players[big], g.ante)
+	}
+
+	g.nextActor()
+}
+
+// postDead puts chips into the pot that do not count towards a player's bet.
+func (g *Game) postDead(p *Player, amount uint32) {
+	amount = min32(amount, p.Balance)
+	p.Balance -= amount
+	p.Total += amount
+
+	if p.Balance == 0 {
+		p.Status = AllIn
+	}
+}
+
+// minBet returns the smallest opening bet.
+func (g *Game) minBet() uint32 {
+	if g.bigBlind > 0 {
+		return g.bigBlind
+	}
+
+	return minimumBet
+}
@@ -0,0 +1,141 @@
+package holdem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newHand(g *Game) {
+	g.newRound()
+	g.dealPreFlop()
+	g.startPreFlop()
+}
+
+func newBlindsGame(opts []Option, names ...string) *Game {
+	game := New(opts...)
+	for _, n := range names {
+		game.AddPlayer(n)
+	}
+	newHand(&game)
+
+	return &game
+}
+
+func balances(g *Game) []uint32 {
+	var b []uint32
+	for _, p := range g.players {
+		b = append(b, p.Balance)
+	}
+
+	return b
+}
+
+func TestBlinds(t *testing.T) {
+	game := newBlindsGame([]Option{WithBlinds(1, 2)}, "A", "B", "C")
+
+	assert.Equal(t, "A", game.Button())
+	assert.Equal(t, []uint32{100, 99, 98}, balances(game))
+	assert.Equal(t, "A", game.ToAct())
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 2, 2}, {Raise, 4, 100}}, game.LegalActions("A"))
+
+	assert.NoError(t, game.Call("A"))
+	assert.NoError(t, game.Call("B"))
+	assert.Equal(t, "C", game.ToAct(), "the big blind gets the option")
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Type: Check}, {Raise, 4, 100}}, game.LegalActions("C"))
+	assert.NoError(t, game.Check("C"))
+	assert.Equal(t, "", game.ToAct())
+
+	game.startBets(game.next(game.button))
+	assert.Equal(t, "B", game.ToAct())
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Type: Check}, {Bet, 2, 98}}, game.LegalActions("B"))
+
+	game.finishRound()
+	newHand(game)
+	assert.Equal(t, "B", game.Button())
+	assert.Equal(t, "B", game.ToAct())
+}
+
+func TestBlinds_HeadsUp(t *testing.T) {
+	game := newBlindsGame([]Option{WithBlinds(5, 10)}, "A", "B")
+
+	assert.Equal(t, "A", game.Button())
+	assert.Equal(t, []uint32{95, 90}, balances(game))
+	assert.Equal(t, "A", game.ToAct(), "the button acts first preflop")
+
+	assert.NoError(t, game.Call("A"))
+	assert.NoError(t, game.Check("B"))
+
+	game.startBets(game.next(game.button))
+	assert.Equal(t, "B", game.ToAct(), "the big blind acts first after the flop")
+}
+
+func TestButton_Bust(t *testing.T) {
+	game := newBlindsGame(nil, "A", "B", "C", "D")
+	assert.Equal(t, "A", game.Button())
+	game.finishRound()
+
+	game.players[1].Balance = 0
+	newHand(game)
+
+	assert.Equal(t, 3, len(game.players))
+	assert.Equal(t, "C", game.Button())
+}
+
+func TestButton_Leave(t *testing.T) {
+	game := newBlindsGame(nil, "A", "B", "C", "D")
+	game.finishRound()
+
+	assert.NoError(t, game.LeaveTable("A"))
+	newHand(game)
+	assert.Equal(t, "B", game.Button())
+
+	// Leaving during a hand folds, and frees the seat afterwards.
+	assert.NoError(t, game.LeaveTable("D"))
+	d, _ := game.player("D")
+	assert.Equal(t, Folded, d.Status)
+	game.finishRound()
+
+	newHand(game)
+	assert.Equal(t, 2, len(game.players))
+	assert.Equal(t, "C", game.Button())
+}
+
+func TestAntes(t *testing.T) {
+	game := newBlindsGame([]Option{WithBlinds(1, 2), WithAnte(1)}, "A", "B", "C")
+
+	assert.Equal(t, []uint32{99, 98, 97}, balances(game))
+	assert.Equal(t, uint32(6), game.Pot())
+	assert.Equal(t, uint32(0), game.players[0].Bet)
+	assert.Equal(t, uint32(2), game.currentBet)
+}
+
+func TestBigBlindAnte(t *testing.T) {
+	game := newBlindsGame([]Option{WithBlinds(1, 2), WithBigBlindAnte(3)}, "A", "B", "C")
+
+	assert.Equal(t, []uint32{100, 99, 95}, balances(game))
+	assert.Equal(t, uint32(2), game.players[2].Bet)
+	assert.Equal(t, uint32(6), game.Pot())
+}
+
+func TestStraddle(t *testing.T) {
+	game := newBlindsGame([]Option{WithBlinds(1, 2), WithStraddle()}, "A", "B", "C", "D")
+
+	assert.Equal(t, []uint32{100, 99, 98, 96}, balances(game))
+	assert.Equal(t, "A", game.ToAct())
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 4, 4}, {Raise, 8, 100}}, game.LegalActions("A"))
+
+	assert.NoError(t, game.Call("A"))
+	assert.NoError(t, game.Call("B"))
+	assert.NoError(t, game.Call("C"))
+	assert.Equal(t, "D", game.ToAct(), "the straddle acts last")
+	assert.NoError(t, game.Check("D"))
+	assert.Equal(t, "", game.ToAct())
+}
+
+func TestStraddle_HeadsUp(t *testing.T) {
+	game := newBlindsGame([]Option{WithBlinds(1, 2), WithStraddle()}, "A", "B")
+
+	assert.Equal(t, []uint32{99, 98}, balances(game))
+	assert.Equal(t, "A", game.ToAct())
+}
@@ -1,9 +1,12 @@
 package holdem
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Card represents a single card.
@@ -15,21 +18,59 @@ func NewCard(value, suit int) Card {
 	return Card((value - 2) + (suit * 13))
 }
 
-// NewCardStr makes a card from a string.
+// Errors returned by ParseCard and ParseCards, wrapped in a *ParseError.
+var (
+	ErrBadRank   = errors.New("bad rank")
+	ErrBadSuit   = errors.New("bad suit")
+	ErrTrailing  = errors.New("trailing characters")
+	ErrDuplicate = errors.New("duplicate card")
+)
+
+// ParseError records a card string that could not be parsed and why.
+type ParseError struct {
+	Input string
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("holdem: parsing card %q: %v", e.Input, e.Err)
+}
+
+// Unwrap returns the underlying error, so errors.Is(err, ErrBadRank) works.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// NewCardStr makes a card from a string. It panics if the string is not a
+// valid card; use ParseCard for untrusted input.
 func NewCardStr(str string) Card {
-	str = strings.ToLower(str)
+	c, err := ParseCard(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// ParseCard parses a single card such as "As", "10h", "Td" or "Q♠".
+// Ranks and suit letters are case-insensitive, and both the black and the
+// white Unicode suit symbols are accepted.
+func ParseCard(str string) (Card, error) {
+	s := strings.TrimSpace(str)
 	var value, suit int
 
-	index := 0
-	switch str[index] {
+	r, n := utf8.DecodeRuneInString(s)
+	switch unicode.ToLower(r) {
 	case '2', '3', '4', '5', '6', '7', '8', '9':
-		value = int(str[index] - '2')
+		value = int(r - '2')
 	case '1':
-		value = 8
-		if str[index+1] != '0' {
-			panic("Malformed 10")
+		if !strings.HasPrefix(s[n:], "0") {
+			return 0, &ParseError{str, ErrBadRank}
 		}
-		index++
+		value = 8
+		n++
+	case 't':
+		value = 8
 	case 'j':
 		value = 9
 	case 'q':
@@ -38,21 +79,54 @@ func NewCardStr(str string) Card {
 		value = 11
 	case 'a':
 		value = 12
+	default:
+		return 0, &ParseError{str, ErrBadRank}
 	}
-	index++
+	s = s[n:]
 
-	switch rune(str[index]) {
-	case 'c', '\u2663':
+	r, n = utf8.DecodeRuneInString(s)
+	switch unicode.ToLower(r) {
+	case 'c', '\u2663', '\u2667':
 		suit = Clubs
-	case 'd', '\u2666':
+	case 'd', '\u2666', '\u2662':
 		suit = Diamonds
-	case 'h', '\u2665':
+	case 'h', '\u2665', '\u2661':
 		suit = Hearts
-	case 's', '\u2660':
+	case 's', '\u2660', '\u2664':
 		suit = Spades
+	default:
+		return 0, &ParseError{str, ErrBadSuit}
+	}
+
+	if s[n:] != "" {
+		return 0, &ParseError{str, ErrTrailing}
+	}
+
+	return Card(value + (suit * 13)), nil
+}
+
+// ParseCards parses a list of cards separated by whitespace or commas.
+// A card that appears more than once is reported as ErrDuplicate.
+func ParseCards(str string) ([]Card, error) {
+	fields := strings.FieldsFunc(str, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	var seen Hand
+	cards := make([]Card, 0, len(fields))
+	for _, f := range fields {
+		c, err := ParseCard(f)
+		if err != nil {
+			return nil, err
+		}
+		if seen&(1<<c) != 0 {
+			return nil, &ParseError{f, ErrDuplicate}
+		}
+		seen |= 1 << c
+		cards = append(cards, c)
 	}
 
-	return Card(value + (suit * 13))
+	return cards, nil
 }
 
 // Format implements Formatter.
@@ -73,7 +147,7 @@ func (c Card) Format(f fmt.State, kind rune) {
 		case 12:
 			valueStr = "A"
 		default:
-			valueStr = string(value + '2')
+			valueStr = string(rune(value + '2'))
 		}
 
 		io.WriteString(f, valueStr)
@@ -101,7 +175,7 @@ func (c Card) String() string {
 	case 12:
 		valueStr = "A"
 	default:
-		valueStr = string(value + '2')
+		valueStr = string(rune(value + '2'))
 	}
 
 	switch suit {
@@ -1,6 +1,7 @@
 package holdem
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -17,6 +18,112 @@ func TestCard_New(t *testing.T) {
 	}
 }
 
+func TestParseCard(t *testing.T) {
+	t.Parallel()
+
+	cards := []struct {
+		Input  string
+		Expect Card
+	}{
+		{"2c", NewCard(2, Clubs)},
+		{"9D", NewCard(9, Diamonds)},
+		{"10h", NewCard(10, Hearts)},
+		{"Ts", NewCard(10, Spades)},
+		{"tS", NewCard(10, Spades)},
+		{"Jc", NewCard(11, Clubs)},
+		{"q♦", NewCard(12, Diamonds)},
+		{"K♡", NewCard(13, Hearts)},
+		{"A♤", NewCard(14, Spades)},
+		{"a♧", NewCard(14, Clubs)},
+		{" 3s ", NewCard(3, Spades)},
+	}
+
+	for _, c := range cards {
+		got, err := ParseCard(c.Input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", c.Input, err)
+		} else if got != c.Expect {
+			t.Errorf("%q: expected: %v, got: %v", c.Input, c.Expect, got)
+		}
+	}
+}
+
+func TestParseCard_Errors(t *testing.T) {
+	t.Parallel()
+
+	cards := []struct {
+		Input  string
+		Expect error
+	}{
+		{"", ErrBadRank},
+		{"1", ErrBadRank},
+		{"11h", ErrBadRank},
+		{"xs", ErrBadRank},
+		{"0s", ErrBadRank},
+		{"A", ErrBadSuit},
+		{"10", ErrBadSuit},
+		{"Kx", ErrBadSuit},
+		{"Ahh", ErrTrailing},
+		{"10s!", ErrTrailing},
+	}
+
+	for _, c := range cards {
+		_, err := ParseCard(c.Input)
+		if !errors.Is(err, c.Expect) {
+			t.Errorf("%q: expected: %v, got: %v", c.Input, c.Expect, err)
+		}
+
+		var perr *ParseError
+		if !errors.As(err, &perr) || perr.Input != c.Input {
+			t.Errorf("%q: expected a *ParseError for the input, got: %#v", c.Input, err)
+		}
+	}
+}
+
+func TestParseCards(t *testing.T) {
+	t.Parallel()
+
+	cards, err := ParseCards("As, kd  10h,tc\tQ♠")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	exp := []Card{
+		NewCard(14, Spades),
+		NewCard(13, Diamonds),
+		NewCard(10, Hearts),
+		NewCard(10, Clubs),
+		NewCard(12, Spades),
+	}
+	if fmt.Sprint(exp) != fmt.Sprint(cards) {
+		t.Errorf("Expected: %v, got: %v", exp, cards)
+	}
+
+	if _, err := ParseCards("As Kd as"); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Expected: %v, got: %v", ErrDuplicate, err)
+	}
+
+	if _, err := ParseCards("As 1d"); !errors.Is(err, ErrBadRank) {
+		t.Errorf("Expected: %v, got: %v", ErrBadRank, err)
+	}
+
+	if cards, err := ParseCards(" , "); err != nil || len(cards) != 0 {
+		t.Errorf("Expected no cards, got: %v %v", cards, err)
+	}
+}
+
+func TestNewCardStr_Panics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected NewCardStr to panic on a malformed card.")
+		}
+	}()
+
+	NewCardStr("1s")
+}
+
 func TestCard_String(t *testing.T) {
 	t.Parallel()
 
@@ -108,3 +215,36 @@ func TestCard_Suit(t *testing.T) {
 		t.Error("Expected it to be the spades suit.")
 	}
 }
+
+func FuzzNewCardStr(f *testing.F) {
+	for _, s := range []string{"As", "10h", "Td", "q♠", " 2♧ ", "1s", "11h", "Ax", "Ks!"} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, str string) {
+		c, err := ParseCard(str)
+		if err != nil {
+			if !errors.Is(err, ErrBadRank) && !errors.Is(err, ErrBadSuit) && !errors.Is(err, ErrTrailing) {
+				t.Fatalf("%q: unexpected error: %v", str, err)
+			}
+
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%q: expected NewCardStr to panic with: %v", str, err)
+				}
+			}()
+			NewCardStr(str)
+			return
+		}
+
+		if c >= DeckSize {
+			t.Fatalf("%q: parsed to card %d, past the end of the deck", str, c)
+		}
+		if got := NewCardStr(str); got != c {
+			t.Fatalf("%q: expected: %v, got: %v", str, c, got)
+		}
+		if got := NewCardStr(c.String()); got != c {
+			t.Fatalf("%q: %v parses back to %v", str, c, got)
+		}
+	})
+}
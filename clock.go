@@ -0,0 +1,123 @@
+package holdem
+
+import (
+	"context"
+	"time"
+)
+
+// Clock tells the time and runs timers for action deadlines. Tests can
+// replace it to move time forward by hand.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// TimeWarning is emitted when a player is running out of time to act.
+type TimeWarning struct {
+	Player string
+	Left   time.Duration
+}
+
+// ActionTimedOut is emitted when a player runs out of time, or the game's
+// context is done, before they act. The player then checks if they can, and
+// folds otherwise.
+type ActionTimedOut struct {
+	Player string
+}
+
+func (TimeWarning) event()    {}
+func (ActionTimedOut) event() {}
+
+// WithClock sets the clock action deadlines are measured with.
+func WithClock(c Clock) Option {
+	return func(g *Game) {
+		g.clock = c
+	}
+}
+
+// WithActionTimeout gives players d to act, after which they start using
+// their time bank. Without it players may take as long as they like.
+func WithActionTimeout(d time.Duration) Option {
+	return func(g *Game) {
+		g.actionTimeout = d
+	}
+}
+
+// WithTimeBank gives every player d of extra time for the whole session,
+// used up whenever they take longer than the action timeout.
+func WithTimeBank(d time.Duration) Option {
+	return func(g *Game) {
+		g.timeBank = d
+	}
+}
+
+// WithTimeWarning makes the game emit a TimeWarning d before a player's
+// time runs out.
+func WithTimeWarning(d time.Duration) Option {
+	return func(g *Game) {
+		g.timeWarning = d
+	}
+}
+
+// decide asks the decider for the action of player, while keeping the
+// clock. It returns false if the player ran out of time or ctx was done.
+func (g *Game) decide(ctx context.Context, player string) (Action, bool) {
+	p, _ := g.player(player)
+	legal := g.LegalActions(player)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var warn, expire <-chan time.Time
+	limit := g.actionTimeout + p.TimeBank
+	if g.actionTimeout > 0 {
+		expire = g.clock.After(limit)
+		if g.timeWarning > 0 && g.timeWarning < limit {
+			warn = g.clock.After(limit - g.timeWarning)
+		}
+	}
+
+	start := g.clock.Now()
+	done := make(chan Action, 1)
+	go func() {
+		done <- g.decider.Decide(ctx, g, player, legal)
+	}()
+
+	for {
+		select {
+		case a := <-done:
+			if used := g.clock.Now().Sub(start); g.actionTimeout > 0 && used > g.actionTimeout {
+				p.TimeBank -= minDuration(used-g.actionTimeout, p.TimeBank)
+			}
+			return a, true
+		case <-warn:
+			warn = nil
+			g.emit(TimeWarning{player, g.timeWarning})
+		case <-expire:
+			p.TimeBank = 0
+			g.emit(ActionTimedOut{player})
+			return Action{}, false
+		case <-ctx.Done():
+			g.emit(ActionTimedOut{player})
+			return Action{}, false
+		}
+	}
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,183 @@
+package holdem
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTimer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// fakeClock only moves when told to.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []fakeTimer
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := fakeTimer{c.now.Add(d), make(chan time.Time, 1)}
+	c.timers = append(c.timers, t)
+	return t.ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	timers := c.timers[:0]
+	for _, t := range c.timers {
+		if t.at.After(c.now) {
+			timers = append(timers, t)
+		} else {
+			t.ch <- c.now
+		}
+	}
+	c.timers = timers
+}
+
+// timedGame seats A and B heads up. A is asked through the channels, and B
+// always checks or calls.
+func timedGame(clock Clock, asked chan<- context.Context, answer <-chan Action, opts ...Option) (*Game, chan Event) {
+	decider := DeciderFunc(func(ctx context.Context, g *Game, name string, legal []LegalAction) Action {
+		if name == "A" {
+			asked <- ctx
+			select {
+			case a := <-answer:
+				return a
+			case <-ctx.Done():
+				return Action{Type: Raise, Amount: 1000}
+			}
+		}
+
+		if legal[1].Type == Call {
+			return Action{Type: Call}
+		}
+		return Action{Type: Check}
+	})
+
+	opts = append(opts, WithClock(clock), WithBlinds(1, 2), WithDecider(decider))
+	game := New(opts...)
+	game.AddPlayer("A")
+	game.AddPlayer("B")
+
+	events := make(chan Event, 100)
+	game.Subscribe(ChanObserver(events))
+
+	return &game, events
+}
+
+// nextTimeEvent skips to the next timing event, or the end of the hand.
+func nextTimeEvent(events <-chan Event) Event {
+	for e := range events {
+		switch e.(type) {
+		case TimeWarning, ActionTimedOut, HandEnded:
+			return e
+		}
+	}
+
+	return nil
+}
+
+func TestActionTimeout(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	asked := make(chan context.Context)
+	game, events := timedGame(clock, asked, nil,
+		WithActionTimeout(30*time.Second), WithTimeBank(15*time.Second), WithTimeWarning(10*time.Second))
+
+	go game.Play()
+
+	ctx := <-asked
+	clock.Advance(34 * time.Second)
+	clock.Advance(time.Second)
+	assert.Equal(t, TimeWarning{"A", 10 * time.Second}, nextTimeEvent(events))
+
+	clock.Advance(10 * time.Second)
+	assert.Equal(t, ActionTimedOut{"A"}, nextTimeEvent(events))
+	<-ctx.Done()
+
+	end, ok := nextTimeEvent(events).(HandEnded)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"B"}, end.Awards[0].Winners)
+
+	a, _ := game.player("A")
+	assert.Equal(t, Folded, a.Status)
+	assert.Equal(t, time.Duration(0), a.TimeBank)
+}
+
+func TestTimeBank(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	asked := make(chan context.Context)
+	answer := make(chan Action)
+	game, events := timedGame(clock, asked, answer,
+		WithActionTimeout(30*time.Second), WithTimeBank(15*time.Second))
+
+	done := make(chan bool)
+	go func() {
+		game.Play()
+		done <- true
+	}()
+
+	<-asked
+	clock.Advance(40 * time.Second)
+	answer <- Action{Type: Call}
+
+	a, _ := game.player("A")
+	for i := 0; i < 3; i++ {
+		<-asked
+		answer <- Action{Type: Check}
+	}
+	<-done
+
+	_, ok := nextTimeEvent(events).(HandEnded)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, a.TimeBank)
+}
+
+func TestPlayContext(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	asked := make(chan context.Context, 10)
+	game, events := timedGame(clock, asked, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	game.PlayContext(ctx)
+
+	assert.Equal(t, ActionTimedOut{"A"}, nextTimeEvent(events))
+	a, _ := game.player("A")
+	assert.Equal(t, Folded, a.Status)
+}
+
+func TestBetTimeout(t *testing.T) {
+	game := newBlindsGame([]Option{WithBlinds(1, 2)}, "A", "B", "C")
+
+	game.BetTimeout("B")
+	assert.Equal(t, "A", game.ToAct())
+
+	game.BetTimeout("A")
+	a, _ := game.player("A")
+	assert.Equal(t, Folded, a.Status)
+
+	assert.NoError(t, game.Call("B"))
+	game.BetTimeout("C")
+	c, _ := game.player("C")
+	assert.Equal(t, Active, c.Status, "the big blind checks")
+	assert.Equal(t, "", game.ToAct())
+}
@@ -0,0 +1,187 @@
+// Command genlookups writes lookups.go, the tables the hand evaluators use
+// to score the ranks of a hand at once. Every table is worked out from the
+// 13 bit rank masks it is indexed by, so it can be audited here rather than
+// in the output. Run it with go generate from the root of the package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math/bits"
+	"os"
+)
+
+const (
+	numRanks = 13
+	numCards = 52
+	numMasks = 1 << numRanks
+	lineLen  = 72 // Longest line of table values, not counting the tab
+	ace      = numRanks - 1
+)
+
+// table is a lookup table and how to print it.
+type table struct {
+	name   string
+	typ    string
+	digits int // Hex digits to print, at least
+	values func(i int) uint64
+	len    int
+}
+
+var tables = []table{
+	{"nBitsTable", "uint16", 2, nBits, numMasks},
+	{"nBitsAndStrTable", "uint16", 2, nBitsAndStr, numMasks},
+	{"straightTable", "uint16", 2, straight, numMasks},
+	{"topFiveCardsTable", "uint32", 8, topFiveCards, numMasks},
+	{"topCardTable", "uint16", 2, topCard, numMasks},
+	{"cardMasksTable", "uint64", 1, cardMask, numCards},
+	{"bottomFiveCardsTable", "uint32", 8, bottomFiveCards, numMasks},
+	{"shortStraightTable", "uint16", 2, shortStraight, numMasks},
+}
+
+func main() {
+	out := flag.String("o", "lookups.go", "file to write")
+	flag.Parse()
+
+	src, err := generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the source of lookups.go.
+func generate() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by cmd/genlookups; DO NOT EDIT.\n\npackage holdem\n\n")
+
+	writeBitCounts(&b)
+	for _, t := range tables {
+		b.WriteString("\n")
+		writeTable(&b, t)
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// writeBitCounts writes the number of bits set in every byte, 16 to a line.
+func writeBitCounts(b *bytes.Buffer) {
+	b.WriteString("var bitCounts = []byte{\n")
+	for i := 0; i < 256; i += 16 {
+		b.WriteString("\t")
+		for j := i; j < i+16; j++ {
+			fmt.Fprintf(b, "%d, ", bits.OnesCount8(uint8(j)))
+		}
+		fmt.Fprintf(b, "/* %-3d - %-3d */\n", i, i+15)
+	}
+	b.WriteString("}\n")
+}
+
+// writeTable writes the values of t in hex, as many to a line as fit.
+func writeTable(b *bytes.Buffer, t table) {
+	fmt.Fprintf(b, "var %s = []%s{\n", t.name, t.typ)
+
+	line := ""
+	for i := 0; i < t.len; i++ {
+		v := fmt.Sprintf("%#0*x,", t.digits, t.values(i))
+		switch {
+		case line == "":
+			line = v
+		case len(line)+1+len(v) > lineLen:
+			fmt.Fprintf(b, "\t%s\n", line)
+			line = v
+		default:
+			line += " " + v
+		}
+	}
+	fmt.Fprintf(b, "\t%s\n}\n", line)
+}
+
+func nBits(mask int) uint64 {
+	return uint64(bits.OnesCount(uint(mask)))
+}
+
+// nBitsAndStr is the number of ranks shifted up by two, then a bit set if
+// there are five or more ranks, and another if they make a straight.
+func nBitsAndStr(mask int) uint64 {
+	v := nBits(mask) << 2
+	if nBits(mask) >= 5 {
+		v |= 1
+	}
+	if straight(mask) != 0 {
+		v |= 2
+	}
+
+	return v
+}
+
+// straight is the top rank of the best straight, 3 for the wheel, or 0.
+func straight(mask int) uint64 {
+	for top := ace; top >= 4; top-- {
+		if run := 0x1F << (top - 4); mask&run == run {
+			return uint64(top)
+		}
+	}
+	if wheel := 1<<ace | 0xF; mask&wheel == wheel {
+		return 3
+	}
+
+	return 0
+}
+
+// shortStraight is like straight for a deck without twos to fives, where
+// the ace plays low in A-6-7-8-9 instead of the wheel.
+func shortStraight(mask int) uint64 {
+	for top := ace; top >= 4; top-- {
+		if run := 0x1F << (top - 4); mask&run == run {
+			return uint64(top)
+		}
+	}
+	if low := 1<<ace | 0xF<<4; mask&low == low {
+		return 7
+	}
+
+	return 0
+}
+
+// topFiveCards packs the five highest ranks, the highest at bits 16-19.
+func topFiveCards(mask int) uint64 {
+	var v uint64
+	shift := 16
+	for r := ace; r >= 0 && shift >= 0; r-- {
+		if mask&(1<<r) != 0 {
+			v |= uint64(r) << shift
+			shift -= 4
+		}
+	}
+
+	return v
+}
+
+// bottomFiveCards packs the five lowest ranks like topFiveCards, the
+// highest of them first.
+func bottomFiveCards(mask int) uint64 {
+	var low int
+	for r := 0; r < numRanks && bits.OnesCount(uint(low)) < 5; r++ {
+		low |= mask & (1 << r)
+	}
+
+	return topFiveCards(low)
+}
+
+func topCard(mask int) uint64 {
+	if mask == 0 {
+		return 0
+	}
+
+	return uint64(bits.Len(uint(mask)) - 1)
+}
+
+func cardMask(card int) uint64 {
+	return 1 << card
+}
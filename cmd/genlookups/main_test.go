@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	want, err := generate()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got, err := os.ReadFile("../../lookups.go")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Error("lookups.go is out of date, run go generate")
+	}
+}
+
+func TestStraight(t *testing.T) {
+	cases := []struct {
+		Mask     int
+		Straight uint64
+		Short    uint64
+	}{
+		{0x1F, 4, 4},           // 2-6
+		{0x100F, 3, 0},         // Wheel
+		{0x10F0, 0, 7},         // A-6-7-8-9
+		{0x1F00, 12, 12},       // Broadway
+		{0x1FFF, 12, 12},       // Every rank
+		{0x17F, 6, 6},          // 2-8 and a 10
+		{0x10F0 | 0x100, 8, 8}, // 6-10 beats A-6-7-8-9
+		{0x100F | 0x10, 4, 4},  // 2-6 beats the wheel
+		{0x0F0F, 0, 0},         // No five in a row
+		{0x1E00 | 0x1, 0, 0},   // No wrapping around past the ace
+	}
+
+	for _, c := range cases {
+		if got := straight(c.Mask); got != c.Straight {
+			t.Errorf("straight(%#x): expected: %d, got: %d", c.Mask, c.Straight, got)
+		}
+		if got := shortStraight(c.Mask); got != c.Short {
+			t.Errorf("shortStraight(%#x): expected: %d, got: %d", c.Mask, c.Short, got)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package holdem
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+)
+
+// ErrMissingCard is returned by Deck.Remove for a card that is not in the deck.
+var ErrMissingCard = errors.New("holdem: card is not in the deck")
+
+// intner is the randomness a Deck shuffles with.
+type intner interface {
+	// Intn returns a uniform number in [0, n).
+	Intn(n int) int
+}
+
+// Deck is a pile of cards that shuffles with randomness injected by the
+// caller, so a known seed always produces the same order.
+type Deck struct {
+	cards []Card
+	decks int
+	short bool
+	rand  intner
+}
+
+// NewDeck creates a full, ordered deck of Decks decks that shuffles with src.
+func NewDeck(src rand.Source) *Deck {
+	return newDeck(rand.New(src))
+}
+
+// NewDeckReader creates a full, ordered deck that shuffles with bytes read
+// from r. Shuffle panics if r returns an error.
+func NewDeckReader(r io.Reader) *Deck {
+	return newDeck(&readerRand{r: r})
+}
+
+func newDeck(r intner) *Deck {
+	d := &Deck{decks: Decks, rand: r}
+	d.Reset()
+
+	return d
+}
+
+// SetDecks changes the number of standard decks shuffled together, and
+// resets the deck. It panics if n is less than one.
+func (d *Deck) SetDecks(n int) {
+	if n < 1 {
+		panic("holdem: a deck needs at least one set of cards")
+	}
+
+	d.decks = n
+	d.Reset()
+}
+
+// Decks returns the number of standard decks shuffled together.
+func (d *Deck) Decks() int {
+	return d.decks
+}
+
+// SetShortDeck switches between standard decks and short decks, which
+// have no twos to fives, and resets the deck.
+func (d *Deck) SetShortDeck(short bool) {
+	d.short = short
+	d.Reset()
+}
+
+// ShortDeck returns whether the deck is made of short decks.
+func (d *Deck) ShortDeck() bool {
+	return d.short
+}
+
+// Reset puts every card back into the deck, in order.
+func (d *Deck) Reset() {
+	d.cards = d.cards[:0]
+	for i := 0; i < d.decks; i++ {
+		for c := 0; c < DeckSize; c++ {
+			if d.short && Card(c).Value() < 4 {
+				continue
+			}
+			d.cards = append(d.cards, Card(c))
+		}
+	}
+}
+
+// Shuffle randomizes the order of the cards left in the deck.
+func (d *Deck) Shuffle() {
+	for i := len(d.cards) - 1; i > 0; i-- {
+		j := d.rand.Intn(i + 1)
+		d.cards[i], d.cards[j] = d.cards[j], d.cards[i]
+	}
+}
+
+// Deal takes n cards from the top of the deck. It panics if fewer than n
+// cards remain.
+func (d *Deck) Deal(n int) []Card {
+	c := make([]Card, n)
+	copy(c, d.cards[:n])
+	d.cards = d.cards[n:]
+
+	return c
+}
+
+// Burn discards the top card of the deck and returns it.
+func (d *Deck) Burn() Card {
+	c := d.cards[0]
+	d.cards = d.cards[1:]
+
+	return c
+}
+
+// Remaining returns the number of cards left in the deck.
+func (d *Deck) Remaining() int {
+	return len(d.cards)
+}
+
+// Remove takes the given cards out of the deck, wherever they are. The deck
+// is left untouched if any of them is missing.
+func (d *Deck) Remove(cards ...Card) error {
+	left := make([]Card, len(d.cards))
+	copy(left, d.cards)
+
+	for _, c := range cards {
+		found := false
+		for i, k := range left {
+			if k == c {
+				left = append(left[:i], left[i+1:]...)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("%w: %v", ErrMissingCard, c)
+		}
+	}
+
+	d.cards = left
+	return nil
+}
+
+// readerRand draws uniform numbers from a stream of random bytes.
+type readerRand struct {
+	r   io.Reader
+	buf [8]byte
+}
+
+// Intn uses rejection sampling, so every result is equally likely.
+func (rr *readerRand) Intn(n int) int {
+	if n <= 0 {
+		panic("holdem: invalid argument to Intn")
+	}
+
+	max := uint64(math.MaxUint64)
+	rem := (max%uint64(n) + 1) % uint64(n)
+	for {
+		if _, err := io.ReadFull(rr.r, rr.buf[:]); err != nil {
+			panic("holdem: reading randomness: " + err.Error())
+		}
+
+		if v := binary.LittleEndian.Uint64(rr.buf[:]); v <= max-rem {
+			return int(v % uint64(n))
+		}
+	}
+}
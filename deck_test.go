@@ -0,0 +1,146 @@
+package holdem
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestDeck_Shuffle(t *testing.T) {
+	t.Parallel()
+
+	d1 := NewDeck(rand.NewSource(7))
+	d2 := NewDeck(rand.NewSource(7))
+	d1.Shuffle()
+	d2.Shuffle()
+
+	if exp, got := Decks*DeckSize, d1.Remaining(); exp != got {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+
+	seen := make(map[Card]int)
+	for i, c := range d1.cards {
+		if c != d2.cards[i] {
+			t.Fatal("Expected the same seed to give the same order.")
+		}
+		seen[c]++
+	}
+	for c, n := range seen {
+		if n != Decks {
+			t.Errorf("Expected %v to appear %d times, got: %d", c, Decks, n)
+		}
+	}
+}
+
+func TestDeck_SetDecks(t *testing.T) {
+	t.Parallel()
+
+	d := NewDeck(rand.NewSource(1))
+	d.SetDecks(2)
+	d.Shuffle()
+
+	if exp, got := 2*DeckSize, d.Remaining(); exp != got {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+
+	seen := make(map[Card]int)
+	for _, c := range d.cards {
+		seen[c]++
+	}
+	for c, n := range seen {
+		if n != 2 {
+			t.Errorf("Expected %v to appear twice, got: %d", c, n)
+		}
+	}
+
+	if err := d.Remove(NewCardStr("As"), NewCardStr("As")); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := d.Remove(NewCardStr("As")); !errors.Is(err, ErrMissingCard) {
+		t.Errorf("Expected: %v, got: %v", ErrMissingCard, err)
+	}
+}
+
+func TestDeck_DealBurn(t *testing.T) {
+	t.Parallel()
+
+	d := NewDeck(rand.NewSource(1))
+	if exp, got := NewCard(2, Clubs), d.Burn(); exp != got {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+
+	cards := d.Deal(3)
+	exp := []Card{NewCard(3, Clubs), NewCard(4, Clubs), NewCard(5, Clubs)}
+	for i := range exp {
+		if exp[i] != cards[i] {
+			t.Errorf("Expected: %v, got: %v", exp, cards)
+		}
+	}
+
+	if exp, got := Decks*DeckSize-4, d.Remaining(); exp != got {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+
+	d.Reset()
+	if exp, got := Decks*DeckSize, d.Remaining(); exp != got {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+}
+
+func TestDeck_Remove(t *testing.T) {
+	t.Parallel()
+
+	d := NewDeck(rand.NewSource(1))
+	if err := d.Remove(NewCardStr("As"), NewCardStr("Kh")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if exp, got := Decks*DeckSize-2, d.Remaining(); exp != got {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+
+	for _, c := range d.cards {
+		if c == NewCardStr("As") || c == NewCardStr("Kh") {
+			t.Errorf("Expected %v to be removed.", c)
+		}
+	}
+
+	if err := d.Remove(NewCardStr("2c"), NewCardStr("As")); !errors.Is(err, ErrMissingCard) {
+		t.Errorf("Expected: %v, got: %v", ErrMissingCard, err)
+	}
+	if exp, got := Decks*DeckSize-2, d.Remaining(); exp != got {
+		t.Errorf("Expected a failed Remove to leave the deck alone, got %d cards", got)
+	}
+}
+
+func TestDeck_Reader(t *testing.T) {
+	t.Parallel()
+
+	seed := bytes.Repeat([]byte{0x5a, 0x01, 0xf3, 0x7c}, 1024)
+	d1 := NewDeckReader(bytes.NewReader(seed))
+	d2 := NewDeckReader(bytes.NewReader(seed))
+	d1.Shuffle()
+	d2.Shuffle()
+
+	for i := range d1.cards {
+		if d1.cards[i] != d2.cards[i] {
+			t.Fatal("Expected the same bytes to give the same order.")
+		}
+	}
+}
+
+func TestReaderRand_Uniform(t *testing.T) {
+	t.Parallel()
+
+	r := &readerRand{r: rand.New(rand.NewSource(3))}
+	counts := make([]int, 6)
+	for i := 0; i < 60000; i++ {
+		counts[r.Intn(6)]++
+	}
+
+	for i, n := range counts {
+		if n < 9500 || n > 10500 {
+			t.Errorf("Expected about 10000 draws of %d, got: %d", i, n)
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package holdem
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+// Errors returned by Equity.
+var (
+	ErrTooFewPlayers = errors.New("holdem: equity needs at least two players")
+	ErrHoleCards     = errors.New("holdem: every player needs two hole cards")
+	ErrBoardSize     = errors.New("holdem: a board has at most five cards")
+	ErrSamples       = errors.New("holdem: too many boards to enumerate, and no samples to draw")
+)
+
+// DefaultExactLimit is the largest number of boards Equity enumerates
+// instead of sampling. It covers every heads up preflop all-in, which has
+// 1,712,304 boards.
+const DefaultExactLimit = 2000000
+
+// equityChunk is the number of samples drawn from each seed. Samples are
+// split into chunks up front, so the result only depends on the seed, not
+// on how the chunks were spread over the workers.
+const equityChunk = 4096
+
+// PlayerEquity is how one player's hand fares over every runout of the
+// board. Win, Tie and Lose add up to one.
+type PlayerEquity struct {
+	Win   float64 // Runouts won outright
+	Tie   float64 // Runouts split with others
+	Lose  float64 // Runouts lost
+	Share float64 // Expected share of the pot, counting split pots
+}
+
+// EquityResult is the outcome of an equity calculation.
+type EquityResult struct {
+	Players []PlayerEquity // In the order the hole cards were given
+	Exact   bool           // Every board was enumerated, rather than sampled
+	Runouts int            // Boards enumerated or sampled
+}
+
+// EquityOption configures an equity calculation.
+type EquityOption func(*equityConfig)
+
+type equityConfig struct {
+	seed       int64
+	workers    int
+	exactLimit int
+}
+
+// WithEquitySeed sets the seed samples are drawn with. The same seed always
+// gives the same result, whatever the number of workers.
+func WithEquitySeed(seed int64) EquityOption {
+	return func(c *equityConfig) {
+		c.seed = seed
+	}
+}
+
+// WithWorkers sets the number of goroutines dealing boards. It defaults to
+// the number of CPUs.
+func WithWorkers(n int) EquityOption {
+	return func(c *equityConfig) {
+		c.workers = n
+	}
+}
+
+// WithExactLimit sets the largest number of boards to enumerate rather than
+// sample. Zero always samples.
+func WithExactLimit(n int) EquityOption {
+	return func(c *equityConfig) {
+		c.exactLimit = n
+	}
+}
+
+// Equity works out the chances of each player's hole cards. When there are
+// no more possible boards than samples, or than the exact limit, it deals
+// every one of them; otherwise it deals the rest of the board at random
+// samples times, which must then be positive. Cards in board and dead are
+// never dealt.
+func Equity(hole [][]Card, board, dead []Card, samples int, opts ...EquityOption) (EquityResult, error) {
+	c := equityConfig{workers: runtime.NumCPU(), exactLimit: DefaultExactLimit}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.workers < 1 {
+		c.workers = 1
+	}
+
+	hands, boardMask, stub, err := equitySetup(hole, board, dead)
+	if err != nil {
+		return EquityResult{}, err
+	}
+
+	var t equityTally
+	need := 5 - len(board)
+	exact := false
+	if boards := binomial(len(stub), need); c.exactLimit > 0 && (boards <= samples || boards <= c.exactLimit) {
+		t = enumerateEquity(hands, boardMask, stub, need, c.workers)
+		exact = true
+	} else {
+		if samples <= 0 {
+			return EquityResult{}, fmt.Errorf("%w: %d", ErrSamples, samples)
+		}
+		t = sampleEquity(hands, boardMask, stub, need, samples, c)
+	}
+
+	return EquityResult{Players: t.equity(), Exact: exact, Runouts: t.runouts}, nil
+}
+
+// sampleEquity deals samples random boards.
+func sampleEquity(hands []Hand, boardMask Hand, stub []Card, need, samples int, c equityConfig) equityTally {
+	tallies := make([]equityTally, numChunks(samples))
+	sampleChunks(samples, c, func() func(i, n int, r *rand.Rand) {
+		cards := make([]Card, len(stub))
+		return func(i, n int, r *rand.Rand) {
+			t := newEquityTally(len(hands))
+			for ; n > 0; n-- {
+				copy(cards, stub)
+				t.add(hands, boardMask|dealRandom(r, cards, need))
+			}
+			tallies[i] = t
+		}
+	})
+
+	return mergeTallies(len(hands), tallies)
+}
+
+// numChunks returns the number of chunks samples are split into.
+func numChunks(samples int) int {
+	return (samples + equityChunk - 1) / equityChunk
+}
+
+// sampleChunks shares the chunks of samples out over c.workers goroutines.
+// Each goroutine gets a function from newWorker, which it calls with the
+// index of every chunk it takes, the number of samples in it, and a source
+// seeded with c.seed plus the index.
+func sampleChunks(samples int, c equityConfig, newWorker func() func(i, n int, r *rand.Rand)) {
+	chunks := numChunks(samples)
+	next := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < c.workers && w < chunks; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			work := newWorker()
+			for i := range next {
+				n := equityChunk
+				if i == chunks-1 {
+					n = samples - i*equityChunk
+				}
+				work(i, n, rand.New(rand.NewSource(c.seed+int64(i))))
+			}
+		}()
+	}
+
+	for i := 0; i < chunks; i++ {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+}
+
+// enumerateEquity deals every board. The boards are split up by their first
+// card, so workers can share them out.
+func enumerateEquity(hands []Hand, boardMask Hand, stub []Card, need, workers int) equityTally {
+	if need == 0 {
+		t := newEquityTally(len(hands))
+		t.add(hands, boardMask)
+		return t
+	}
+
+	tallies := make([]equityTally, len(stub))
+	next := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(stub); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range next {
+				t := newEquityTally(len(hands))
+				enumerateBoards(stub[i+1:], need-1, boardMask|Hand(cardMasksTable[stub[i]]), func(board Hand) {
+					t.add(hands, board)
+				})
+				tallies[i] = t
+			}
+		}()
+	}
+
+	for i := range stub {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	return mergeTallies(len(hands), tallies)
+}
+
+// enumerateBoards calls f with board plus every n cards out of cards.
+func enumerateBoards(cards []Card, n int, board Hand, f func(board Hand)) {
+	if n == 0 {
+		f(board)
+		return
+	}
+
+	for i := 0; i <= len(cards)-n; i++ {
+		enumerateBoards(cards[i+1:], n-1, board|Hand(cardMasksTable[cards[i]]), f)
+	}
+}
+
+// binomial returns n choose k.
+func binomial(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+
+	r := 1
+	for i := 1; i <= k; i++ {
+		r = r * (n - k + i) / i
+	}
+
+	return r
+}
+
+// equitySetup checks the cards of an equity calculation, and returns every
+// player's hole cards as a Hand, the board as a Hand and the cards left to
+// deal.
+func equitySetup(hole [][]Card, board, dead []Card) ([]Hand, Hand, []Card, error) {
+	if len(hole) < 2 {
+		return nil, 0, nil, ErrTooFewPlayers
+	}
+	if len(board) > 5 {
+		return nil, 0, nil, ErrBoardSize
+	}
+
+	var seen Hand
+	use := func(cards []Card) (Hand, error) {
+		var h Hand
+		for _, c := range cards {
+			if seen&(1<<c) != 0 {
+				return 0, fmt.Errorf("%w: %v", ErrDuplicate, c)
+			}
+			seen |= 1 << c
+			h |= 1 << c
+		}
+
+		return h, nil
+	}
+
+	hands := make([]Hand, len(hole))
+	for i, cards := range hole {
+		if len(cards) != 2 {
+			return nil, 0, nil, fmt.Errorf("%w: player %d has %d", ErrHoleCards, i, len(cards))
+		}
+
+		h, err := use(cards)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		hands[i] = h
+	}
+
+	boardMask, err := use(board)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if _, err := use(dead); err != nil {
+		return nil, 0, nil, err
+	}
+
+	var stub []Card
+	for c := Card(0); c < DeckSize; c++ {
+		if seen&(1<<c) == 0 {
+			stub = append(stub, c)
+		}
+	}
+	if len(stub) < 5-len(board) {
+		return nil, 0, nil, fmt.Errorf("%w: not enough cards left to deal the board", ErrMissingCard)
+	}
+
+	return hands, boardMask, stub, nil
+}
+
+// dealRandom moves n random cards to the front of cards, and returns them
+// as a Hand.
+func dealRandom(r *rand.Rand, cards []Card, n int) Hand {
+	var h Hand
+	for i := 0; i < n; i++ {
+		j := i + r.Intn(len(cards)-i)
+		cards[i], cards[j] = cards[j], cards[i]
+		h |= 1 << cards[i]
+	}
+
+	return h
+}
+
+// equityStats adds up how one hand fared over weighted runouts.
+type equityStats struct {
+	weight float64
+	win    float64
+	tie    float64
+	share  float64
+}
+
+// record adds a runout of the given weight, split between winners hands.
+// The hand lost it if it is not among them.
+func (s *equityStats) record(weight float64, won bool, winners int) {
+	s.weight += weight
+	switch {
+	case !won:
+	case winners == 1:
+		s.win += weight
+		s.share += weight
+	default:
+		s.tie += weight
+		s.share += weight / float64(winners)
+	}
+}
+
+func (s *equityStats) merge(o equityStats) {
+	s.weight += o.weight
+	s.win += o.win
+	s.tie += o.tie
+	s.share += o.share
+}
+
+func (s equityStats) equity() PlayerEquity {
+	if s.weight == 0 {
+		return PlayerEquity{}
+	}
+
+	return PlayerEquity{
+		Win:   s.win / s.weight,
+		Tie:   s.tie / s.weight,
+		Lose:  (s.weight - s.win - s.tie) / s.weight,
+		Share: s.share / s.weight,
+	}
+}
+
+// showdown values every hand on a complete board into values, and returns
+// the best value and how many hands share it.
+func showdownValues(hands []Hand, board Hand, values []HandValue) (HandValue, int) {
+	var best HandValue
+	winners := 0
+	for i, h := range hands {
+		v := value7(h | board)
+		values[i] = v
+
+		switch {
+		case v > best:
+			best = v
+			winners = 1
+		case v == best:
+			winners++
+		}
+	}
+
+	return best, winners
+}
+
+// equityTally counts the results of the runouts dealt so far.
+type equityTally struct {
+	runouts int
+	players []equityStats
+	values  []HandValue
+}
+
+func newEquityTally(players int) equityTally {
+	return equityTally{
+		players: make([]equityStats, players),
+		values:  make([]HandValue, players),
+	}
+}
+
+// add scores one complete board.
+func (t *equityTally) add(hands []Hand, board Hand) {
+	best, winners := showdownValues(hands, board, t.values)
+	for i, v := range t.values {
+		t.players[i].record(1, v == best, winners)
+	}
+	t.runouts++
+}
+
+// mergeTallies adds up tallies in order, so the floating point shares come
+// out the same every time.
+func mergeTallies(players int, tallies []equityTally) equityTally {
+	total := newEquityTally(players)
+	for _, t := range tallies {
+		total.merge(t)
+	}
+
+	return total
+}
+
+func (t *equityTally) merge(o equityTally) {
+	t.runouts += o.runouts
+	for i := range t.players {
+		t.players[i].merge(o.players[i])
+	}
+}
+
+func (t *equityTally) equity() []PlayerEquity {
+	eq := make([]PlayerEquity, len(t.players))
+	for i, s := range t.players {
+		eq[i] = s.equity()
+	}
+
+	return eq
+}
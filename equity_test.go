@@ -0,0 +1,183 @@
+package holdem
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestEquity(t *testing.T) {
+	t.Parallel()
+
+	hole := [][]Card{mustParseCards(t, "as ah"), mustParseCards(t, "ks kh")}
+	res, err := Equity(hole, nil, nil, 100000, WithEquitySeed(1), WithExactLimit(0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Exact || res.Runouts != 100000 {
+		t.Errorf("Expected 100000 samples, got: %+v", res)
+	}
+
+	// Aces against kings with no shared suits win about 82% of the time.
+	eq := res.Players
+	if math.Abs(eq[0].Share-0.82) > 0.01 {
+		t.Errorf("Expected aces to have about 82%%, got: %v", eq[0].Share)
+	}
+	for i, e := range eq {
+		if sum := e.Win + e.Tie + e.Lose; math.Abs(sum-1) > 1e-9 {
+			t.Errorf("Player %d: win, tie and lose add up to %v", i, sum)
+		}
+	}
+	if sum := eq[0].Share + eq[1].Share; math.Abs(sum-1) > 1e-9 {
+		t.Errorf("Shares add up to %v", sum)
+	}
+}
+
+func TestEquity_Exact(t *testing.T) {
+	t.Parallel()
+
+	hole := [][]Card{mustParseCards(t, "as ah"), mustParseCards(t, "ks kh")}
+	res, err := Equity(hole, nil, nil, 1000)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !res.Exact || res.Runouts != 1712304 {
+		t.Errorf("Expected every preflop board to be dealt, got: %v boards", res.Runouts)
+	}
+
+	sampled, _ := Equity(hole, nil, nil, 100000, WithEquitySeed(1), WithExactLimit(0))
+	if math.Abs(res.Players[0].Share-sampled.Players[0].Share) > 0.01 {
+		t.Errorf("Expected sampling to agree with enumeration: %v, %v", sampled.Players[0], res.Players[0])
+	}
+
+	// Too many boards to enumerate.
+	hole = append(hole, mustParseCards(t, "qs qh"))
+	res, _ = Equity(hole, nil, nil, 1000, WithExactLimit(100000))
+	if res.Exact || res.Runouts != 1000 {
+		t.Errorf("Expected 1000 samples, got: %v", res.Runouts)
+	}
+
+	// Fewer boards than samples.
+	res, _ = Equity(hole, mustParseCards(t, "2c 3c 4d"), nil, 1000, WithExactLimit(1))
+	if !res.Exact || res.Runouts != 903 {
+		t.Errorf("Expected 903 boards, got: %v", res.Runouts)
+	}
+}
+
+func TestEquity_Deterministic(t *testing.T) {
+	t.Parallel()
+
+	hole := [][]Card{mustParseCards(t, "as kd"), mustParseCards(t, "7c 7h"), mustParseCards(t, "jd 10d")}
+	board := mustParseCards(t, "2d 7s qd")
+
+	one, err := Equity(hole, board, nil, 20000, WithEquitySeed(42), WithWorkers(1), WithExactLimit(0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	many, _ := Equity(hole, board, nil, 20000, WithEquitySeed(42), WithWorkers(8), WithExactLimit(0))
+	if !reflect.DeepEqual(one, many) {
+		t.Errorf("Expected the same result from one and eight workers: %v, %v", one, many)
+	}
+
+	other, _ := Equity(hole, board, nil, 20000, WithEquitySeed(43), WithWorkers(1), WithExactLimit(0))
+	if reflect.DeepEqual(one, other) {
+		t.Error("Expected a different seed to give a different result")
+	}
+
+	one, _ = Equity(hole, board, nil, 0, WithWorkers(1))
+	many, _ = Equity(hole, board, nil, 0, WithWorkers(8))
+	if !reflect.DeepEqual(one, many) {
+		t.Errorf("Expected the same result from one and eight workers: %v, %v", one, many)
+	}
+}
+
+func TestEquity_Board(t *testing.T) {
+	t.Parallel()
+
+	// On a complete board there is a single runout.
+	hole := [][]Card{mustParseCards(t, "as kd"), mustParseCards(t, "ac kh"), mustParseCards(t, "2c 3c")}
+	board := mustParseCards(t, "ad 7s 8h jd 9c")
+	res, err := Equity(hole, board, nil, 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	exp := EquityResult{
+		Players: []PlayerEquity{
+			{Tie: 1, Share: 0.5},
+			{Tie: 1, Share: 0.5},
+			{Lose: 1},
+		},
+		Exact:   true,
+		Runouts: 1,
+	}
+	if !reflect.DeepEqual(res, exp) {
+		t.Errorf("Expected: %v, got: %v", exp, res)
+	}
+
+	// Dead cards are never dealt: with every other diamond gone, the flush
+	// draw is dead and only the six aces and kings win.
+	hole = [][]Card{mustParseCards(t, "ad kd"), mustParseCards(t, "qs qh")}
+	board = mustParseCards(t, "2d 7d 8c 9h")
+	dead := mustParseCards(t, "3d 4d 5d 6d 8d 9d 10d jd qd")
+	res, _ = Equity(hole, board, dead, 5000)
+	if exp := 6.0 / 35; !res.Exact || math.Abs(res.Players[0].Win-exp) > 1e-9 {
+		t.Errorf("Expected: %v, got: %v", exp, res.Players[0].Win)
+	}
+}
+
+func TestEquity_Errors(t *testing.T) {
+	t.Parallel()
+
+	aa, kk := mustParseCards(t, "as ah"), mustParseCards(t, "ks kh")
+	cases := []struct {
+		Hole   [][]Card
+		Board  []Card
+		Dead   []Card
+		Expect error
+	}{
+		{[][]Card{aa}, nil, nil, ErrTooFewPlayers},
+		{[][]Card{aa, mustParseCards(t, "ks")}, nil, nil, ErrHoleCards},
+		{[][]Card{aa, kk}, mustParseCards(t, "2c 3c 4c 5c 6c 7c"), nil, ErrBoardSize},
+		{[][]Card{aa, kk}, mustParseCards(t, "2c 3c as"), nil, ErrDuplicate},
+		{[][]Card{aa, kk}, nil, mustParseCards(t, "kh"), ErrDuplicate},
+	}
+
+	for _, c := range cases {
+		if _, err := Equity(c.Hole, c.Board, c.Dead, 100); !errors.Is(err, c.Expect) {
+			t.Errorf("%v %v %v: expected: %v, got: %v", c.Hole, c.Board, c.Dead, c.Expect, err)
+		}
+	}
+
+	// Sampling needs samples; enumerating does not.
+	for _, samples := range []int{0, -5, -10000} {
+		if _, err := Equity([][]Card{aa, kk}, nil, nil, samples, WithExactLimit(0)); !errors.Is(err, ErrSamples) {
+			t.Errorf("%d samples: expected: %v, got: %v", samples, ErrSamples, err)
+		}
+	}
+	if res, err := Equity([][]Card{aa, kk}, mustParseCards(t, "2c 3d 9h"), nil, 0); err != nil || !res.Exact {
+		t.Errorf("Expected an exact result, got: %v %v", res, err)
+	}
+}
+
+func benchmarkEquity(b *testing.B, board string, samples int, opts ...EquityOption) {
+	hole := [][]Card{{NewCardStr("as"), NewCardStr("ah")}, {NewCardStr("ks"), NewCardStr("kh")}}
+	var cards []Card
+	if board != "" {
+		cards, _ = ParseCards(board)
+	}
+
+	for i := 0; i < b.N; i++ {
+		Equity(hole, cards, nil, samples, opts...)
+	}
+}
+
+func BenchmarkEquity_ExactRiver(b *testing.B)   { benchmarkEquity(b, "2c 7d 9h jc 3s", 0) }
+func BenchmarkEquity_ExactTurn(b *testing.B)    { benchmarkEquity(b, "2c 7d 9h jc", 0) }
+func BenchmarkEquity_ExactFlop(b *testing.B)    { benchmarkEquity(b, "2c 7d 9h", 0) }
+func BenchmarkEquity_ExactPreflop(b *testing.B) { benchmarkEquity(b, "", 0) }
+
+func BenchmarkEquity_Sampled(b *testing.B) {
+	benchmarkEquity(b, "", 10000, WithExactLimit(0))
+}
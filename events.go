@@ -0,0 +1,114 @@
+package holdem
+
+import "context"
+
+// Event is something that happened in a game. It is one of HandStarted,
+// HoleCardsDealt, StreetDealt, ActionTaken, PotAwarded, HandEnded,
+// TimeWarning or ActionTimedOut.
+type Event interface {
+	event()
+}
+
+// HandStarted is emitted once the button has moved and antes are posted.
+// Commitment is only set by provably fair games.
+type HandStarted struct {
+	Button     string
+	Players    []string // In seat order, starting after the button
+	Commitment Commitment
+}
+
+// HoleCardsDealt is emitted for every player dealt into a hand. The cards
+// are private, so an observer relaying events to everyone must filter it.
+type HoleCardsDealt struct {
+	Player string
+	Cards  []Card
+}
+
+// StreetDealt is emitted when community cards are dealt.
+type StreetDealt struct {
+	Street RoundStatus
+	Cards  []Card // The cards just dealt
+	Board  []Card // Every community card so far
+}
+
+// ActionTaken is emitted after a player acts.
+type ActionTaken struct {
+	Player string
+	Action Action
+	Pot    uint32 // Chips in the pot after the action
+}
+
+// PotAwarded is emitted for each pot at the end of a hand.
+type PotAwarded struct {
+	Award
+}
+
+// HandEnded is the last event of a hand. Reveal is only set by provably fair
+// games.
+type HandEnded struct {
+	Awards []Award
+	Reveal Reveal
+}
+
+func (HandStarted) event()    {}
+func (HoleCardsDealt) event() {}
+func (StreetDealt) event()    {}
+func (ActionTaken) event()    {}
+func (PotAwarded) event()     {}
+func (HandEnded) event()      {}
+
+// Observer is told about every event of a game, in order, as it happens.
+// Observers are called from the goroutine running the game, and hold it up
+// until they return.
+type Observer interface {
+	Observe(e Event)
+}
+
+// ObserverFunc lets an ordinary function be used as an Observer.
+type ObserverFunc func(e Event)
+
+// Observe implements Observer.
+func (f ObserverFunc) Observe(e Event) {
+	f(e)
+}
+
+// ChanObserver returns an Observer that sends every event on ch.
+func ChanObserver(ch chan<- Event) Observer {
+	return ObserverFunc(func(e Event) {
+		ch <- e
+	})
+}
+
+// Decider chooses the action of the player to act, from the legal ones.
+// Frontends ask their users, and bots decide for themselves. Decide runs on
+// its own goroutine; once ctx is done the player has run out of time, and
+// Decide should return without touching g again.
+type Decider interface {
+	Decide(ctx context.Context, g *Game, player string, legal []LegalAction) Action
+}
+
+// DeciderFunc lets an ordinary function be used as a Decider.
+type DeciderFunc func(ctx context.Context, g *Game, player string, legal []LegalAction) Action
+
+// Decide implements Decider.
+func (f DeciderFunc) Decide(ctx context.Context, g *Game, player string, legal []LegalAction) Action {
+	return f(ctx, g, player, legal)
+}
+
+// WithDecider sets how the game gets the players' actions.
+func WithDecider(d Decider) Option {
+	return func(g *Game) {
+		g.decider = d
+	}
+}
+
+// Subscribe adds an observer to the game.
+func (g *Game) Subscribe(o Observer) {
+	g.observers = append(g.observers, o)
+}
+
+func (g *Game) emit(e Event) {
+	for _, o := range g.observers {
+		o.Observe(e)
+	}
+}
@@ -0,0 +1,120 @@
+package holdem
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvents(t *testing.T) {
+	game := New(WithRandSource(rand.NewSource(1)), WithBlinds(1, 2),
+		WithDecider(DeciderFunc(func(ctx context.Context, g *Game, name string, legal []LegalAction) Action {
+			for _, l := range legal {
+				if l.Type == Check || l.Type == Call {
+					return Action{Type: l.Type}
+				}
+			}
+			return Action{Type: Fold}
+		})))
+	game.AddPlayer("A")
+	game.AddPlayer("B")
+	game.AddPlayer("C")
+
+	var events []Event
+	game.Subscribe(ObserverFunc(func(e Event) {
+		events = append(events, e)
+	}))
+
+	ch := make(chan Event, 100)
+	game.Subscribe(ChanObserver(ch))
+
+	game.Play()
+
+	assert.Equal(t, len(events), len(ch))
+	assert.Equal(t, HandStarted{Button: "A", Players: []string{"B", "C", "A"}}, events[0])
+
+	for i, name := range []string{"B", "C", "A"} {
+		e, ok := events[1+i].(HoleCardsDealt)
+		assert.True(t, ok)
+		assert.Equal(t, name, e.Player)
+		assert.Equal(t, 2, len(e.Cards))
+	}
+
+	assert.Equal(t, ActionTaken{"A", Action{Type: Call}, 5}, events[4])
+	assert.Equal(t, ActionTaken{"B", Action{Type: Call}, 6}, events[5])
+	assert.Equal(t, ActionTaken{"C", Action{Type: Check}, 6}, events[6])
+
+	flop, ok := events[7].(StreetDealt)
+	assert.True(t, ok)
+	assert.Equal(t, Flop, flop.Street)
+	assert.Equal(t, 3, len(flop.Cards))
+
+	var streets, awarded, ended int
+	for _, e := range events {
+		switch e := e.(type) {
+		case StreetDealt:
+			streets++
+		case PotAwarded:
+			awarded++
+			assert.Equal(t, uint32(6), e.Amount)
+		case HandEnded:
+			ended++
+			assert.Equal(t, game.Awards(), e.Awards)
+		}
+	}
+	assert.Equal(t, 3, streets)
+	assert.Equal(t, 1, awarded)
+	assert.Equal(t, 1, ended)
+	_, ok = events[len(events)-1].(HandEnded)
+	assert.True(t, ok)
+}
+
+func TestEvents_Folds(t *testing.T) {
+	game := New(WithBlinds(1, 2), WithDecider(DeciderFunc(func(ctx context.Context, g *Game, name string, legal []LegalAction) Action {
+		return Action{Type: Fold}
+	})))
+	game.AddPlayer("A")
+	game.AddPlayer("B")
+	game.AddPlayer("C")
+
+	var events []Event
+	game.Subscribe(ObserverFunc(func(e Event) {
+		events = append(events, e)
+	}))
+	game.Play()
+
+	for _, e := range events {
+		_, ok := e.(StreetDealt)
+		assert.False(t, ok)
+	}
+
+	assert.Equal(t, []Award{{Pot: 0, Amount: 3, Winners: []string{"C"}, Shares: []uint32{3}}}, game.Awards())
+	assert.Equal(t, []uint32{100, 99, 101}, balances(&game))
+}
+
+func TestEvents_ProvablyFair(t *testing.T) {
+	game := New(WithProvablyFair())
+	game.AddPlayer("A")
+	game.AddPlayer("B")
+
+	var start HandStarted
+	var end HandEnded
+	var dealt []Card
+	game.Subscribe(ObserverFunc(func(e Event) {
+		switch e := e.(type) {
+		case HandStarted:
+			start = e
+		case HoleCardsDealt:
+			dealt = append(dealt, e.Cards...)
+		case StreetDealt:
+			dealt = append(dealt, e.Cards...)
+		case HandEnded:
+			end = e
+		}
+	}))
+	game.Play()
+
+	assert.NoError(t, Verify(start.Commitment, end.Reveal, dealt))
+}
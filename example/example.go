@@ -1,22 +1,26 @@
 package main
 
 import (
+	"bufio"
+	"context"
 	"fmt"
-	"os"
 	h "holdem"
-	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
-var game h.Game
 var stdin *bufio.Reader
 
 func main() {
-	game := h.New()
+	game := h.New(h.WithBlinds(1, 2), h.WithDecider(h.DeciderFunc(decide)),
+		h.WithActionTimeout(time.Minute), h.WithTimeWarning(15*time.Second))
+	game.Subscribe(h.ObserverFunc(observe))
 
-	game.SetPreRoundCallback(preRoundCallback)
-	game.SetDisplayPlayerCardCallback(displayPlayerCardsCallback)
-	game.SetBetCallback(betCallback)
-	game.SetCommunityCallback(communityCallback)
+	game.AddPlayer("A")
+	game.AddPlayer("B")
+	game.AddPlayer("C")
 
 	stdin = bufio.NewReader(os.Stdin)
 
@@ -25,49 +29,61 @@ func main() {
 	fmt.Println("Done")
 }
 
-func preRoundCallback(g *h.Game, done chan bool) {
-	fmt.Println("PreRound Callback")
-
-	g.AddPlayer("A")
-	g.AddPlayer("B")
-	g.AddPlayer("C")
-
-	done <- true
-}
-
-func displayPlayerCardsCallback(name string, cards []h.Card, done chan bool) {
-	fmt.Printf("%s has cards %s\n", name, cards)
-
-	done <- true
+func observe(e h.Event) {
+	switch e := e.(type) {
+	case h.HandStarted:
+		fmt.Printf("New hand, %s has the button\n", e.Button)
+	case h.HoleCardsDealt:
+		fmt.Printf("%s has cards %s\n", e.Player, e.Cards)
+	case h.StreetDealt:
+		switch e.Street {
+		case h.Flop:
+			fmt.Printf("Flop: %s\n", e.Board)
+		case h.Turn:
+			fmt.Printf("Turn: %s\n", e.Board)
+		case h.River:
+			fmt.Printf("River: %s\n", e.Board)
+		}
+	case h.ActionTaken:
+		fmt.Printf("%s: %v (pot %d)\n", e.Player, e.Action, e.Pot)
+	case h.TimeWarning:
+		fmt.Printf("\n%s, %v left to act\n", e.Player, e.Left)
+	case h.ActionTimedOut:
+		fmt.Printf("\n%s ran out of time\n", e.Player)
+	case h.PotAwarded:
+		fmt.Printf("Pot %d of %d goes to %v with %v\n", e.Pot, e.Amount, e.Winners, e.Hand)
+	}
 }
 
-func betCallback(g *h.Game, name string) {
-	out:
+func decide(ctx context.Context, g *h.Game, name string, legal []h.LegalAction) h.Action {
 	for {
-		fmt.Printf("%s, place your bet [r/c/f]: ", name)
-		line, _, _ := stdin.ReadLine()
-
-		switch line[0] {
-		case 'r':
-			g.Raise(name, 1)
-			break out
-		case 'c':
-			g.Check(name)
-			break out
-		case 'f':
-			g.Fold(name)
-			break out
+		fmt.Printf("%s, place your bet %v [r <amount>/k/c/f]: ", name, legal)
+		line, _ := stdin.ReadString('\n')
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
 		}
-	}
-}
 
-func communityCallback(state h.RoundStatus, cards []h.Card) {
-	switch state {
-	case h.Flop:
-		fmt.Printf("Flop: %s\n", cards)
-	case h.Turn:
-		fmt.Printf("Turn: %s\n", cards)
-	case h.River:
-		fmt.Printf("River: %s\n", cards)
+		switch fields[0] {
+		case "r":
+			if len(fields) < 2 {
+				continue
+			}
+			amount, err := strconv.Atoi(fields[1])
+			if err != nil {
+				continue
+			}
+			for _, l := range legal {
+				if l.Type == h.Bet || l.Type == h.Raise {
+					return h.Action{Type: l.Type, Amount: uint32(amount)}
+				}
+			}
+		case "k":
+			return h.Action{Type: h.Check}
+		case "c":
+			return h.Action{Type: h.Call}
+		case "f":
+			return h.Action{Type: h.Fold}
+		}
 	}
 }
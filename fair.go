@@ -0,0 +1,178 @@
+package holdem
+
+import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+)
+
+// SeedSize is the number of random bytes behind each provably fair deck.
+const SeedSize = 32
+
+// Errors returned by Verify.
+var (
+	ErrBadCommitment = errors.New("holdem: revealed deck does not match the commitment")
+	ErrBadOrder      = errors.New("holdem: deck order does not follow from the seed")
+	ErrBadDeal       = errors.New("holdem: dealt cards do not match the deck order")
+)
+
+// Commitment is the hash a provably fair game publishes before dealing a
+// hand: SHA-256 of the seed followed by the deck order.
+type Commitment [sha256.Size]byte
+
+// String returns the commitment in hex.
+func (c Commitment) String() string {
+	return hex.EncodeToString(c[:])
+}
+
+// Reveal is published after the hand, so players can check it against the
+// Commitment they were shown before it.
+type Reveal struct {
+	Seed  []byte
+	Order []Card
+}
+
+// Commitment computes the commitment for the revealed seed and order.
+func (r Reveal) Commitment() Commitment {
+	h := sha256.New()
+	h.Write(r.Seed)
+	for _, c := range r.Order {
+		h.Write([]byte{byte(c)})
+	}
+
+	var c Commitment
+	copy(c[:], h.Sum(nil))
+	return c
+}
+
+// Verify checks that r matches the commitment c, that the order really
+// follows from the seed, and that dealt, the cards in the order the hand
+// history shows them dealt, came off the top of that deck.
+func Verify(c Commitment, r Reveal, dealt []Card) error {
+	if r.Commitment() != c {
+		return ErrBadCommitment
+	}
+
+	// Short decks are told apart by their missing low cards.
+	short := true
+	for _, c := range r.Order {
+		if c.Value() < 4 {
+			short = false
+			break
+		}
+	}
+
+	size := DeckSize
+	if short {
+		size = ShortDeckSize
+	}
+	decks := len(r.Order) / size
+	if decks == 0 || len(r.Order) != decks*size {
+		return ErrBadOrder
+	}
+
+	order := seedDeck(r.Seed, decks, short).cards
+	for i := range order {
+		if order[i] != r.Order[i] {
+			return ErrBadOrder
+		}
+	}
+
+	if len(dealt) > len(order) {
+		return ErrBadDeal
+	}
+	for i := range dealt {
+		if dealt[i] != order[i] {
+			return ErrBadDeal
+		}
+	}
+
+	return nil
+}
+
+// SeedOrder returns the order of a shoe of the given number of decks that a
+// provably fair game derives from seed.
+func SeedOrder(seed []byte, decks int) []Card {
+	return seedDeck(seed, decks, false).cards
+}
+
+func seedDeck(seed []byte, decks int, short bool) *Deck {
+	d := NewDeckReader(&seedStream{seed: seed})
+	d.SetDecks(decks)
+	d.SetShortDeck(short)
+	d.Shuffle()
+
+	return d
+}
+
+// WithSecureShuffle makes the game shuffle with crypto/rand.
+func WithSecureShuffle() Option {
+	return WithRandReader(crand.Reader)
+}
+
+// WithProvablyFair makes the game shuffle every hand from a fresh secret
+// seed. Commitment can be shown to the players before the hand is dealt,
+// and Reveal after it has finished.
+func WithProvablyFair() Option {
+	return func(g *Game) {
+		g.fair = true
+	}
+}
+
+// Commitment returns the commitment to the current deck of a provably fair
+// game.
+func (g *Game) Commitment() Commitment {
+	return g.reveal.Commitment()
+}
+
+// Reveal returns the seed and deck order of the current hand of a provably
+// fair game. It must not be shown to the players before the hand is over.
+func (g *Game) Reveal() Reveal {
+	return g.reveal
+}
+
+func (g *Game) shuffleFair() {
+	seed := make([]byte, SeedSize)
+	if _, err := io.ReadFull(crand.Reader, seed); err != nil {
+		panic("holdem: reading seed: " + err.Error())
+	}
+
+	g.deck = seedDeck(seed, g.decks, g.short)
+
+	order := make([]Card, g.deck.Remaining())
+	copy(order, g.deck.cards)
+	g.reveal = Reveal{seed, order}
+}
+
+// seedStream expands a seed into an endless stream of bytes by hashing the
+// seed together with a block counter.
+type seedStream struct {
+	seed    []byte
+	counter uint64
+	buf     []byte
+}
+
+func (s *seedStream) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(s.buf) == 0 {
+			var ctr [8]byte
+			binary.BigEndian.PutUint64(ctr[:], s.counter)
+			s.counter++
+
+			h := sha256.New()
+			h.Write(s.seed)
+			h.Write(ctr[:])
+			s.buf = h.Sum(nil)
+		}
+
+		k := copy(p[n:], s.buf)
+		s.buf = s.buf[k:]
+		n += k
+	}
+
+	return n, nil
+}
@@ -0,0 +1,109 @@
+package holdem
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestSeedOrder(t *testing.T) {
+	t.Parallel()
+
+	seed := bytes.Repeat([]byte{1}, SeedSize)
+	o1 := SeedOrder(seed, 1)
+	o2 := SeedOrder(seed, 1)
+
+	if exp, got := DeckSize, len(o1); exp != got {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+	for i := range o1 {
+		if o1[i] != o2[i] {
+			t.Fatal("Expected the same seed to give the same order.")
+		}
+	}
+
+	seed[0] = 2
+	same := true
+	for i, c := range SeedOrder(seed, 1) {
+		if c != o1[i] {
+			same = false
+		}
+	}
+	if same {
+		t.Error("Expected a different seed to give a different order.")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	seed := bytes.Repeat([]byte{9}, SeedSize)
+	r := Reveal{seed, SeedOrder(seed, 1)}
+	c := r.Commitment()
+
+	if err := Verify(c, r, r.Order[:9]); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	other := Reveal{[]byte("other seed"), r.Order}
+	if err := Verify(c, other, nil); !errors.Is(err, ErrBadCommitment) {
+		t.Errorf("Expected: %v, got: %v", ErrBadCommitment, err)
+	}
+
+	swapped := Reveal{seed, append([]Card(nil), r.Order...)}
+	swapped.Order[0], swapped.Order[1] = swapped.Order[1], swapped.Order[0]
+	if err := Verify(swapped.Commitment(), swapped, nil); !errors.Is(err, ErrBadOrder) {
+		t.Errorf("Expected: %v, got: %v", ErrBadOrder, err)
+	}
+
+	dealt := []Card{r.Order[1], r.Order[0]}
+	if err := Verify(c, r, dealt); !errors.Is(err, ErrBadDeal) {
+		t.Errorf("Expected: %v, got: %v", ErrBadDeal, err)
+	}
+}
+
+func TestGame_ProvablyFair(t *testing.T) {
+	t.Parallel()
+
+	game := New(WithProvablyFair())
+	game.shuffleDeck()
+	c := game.Commitment()
+
+	dealt := append(game.dealCards(2), game.dealCards(2)...)
+	dealt = append(dealt, game.dealCards(3)...)
+
+	if err := Verify(c, game.Reveal(), dealt); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	game.shuffleDeck()
+	if c == game.Commitment() {
+		t.Error("Expected every hand to get a fresh commitment.")
+	}
+}
+
+func TestGame_ProvablyFairDecks(t *testing.T) {
+	t.Parallel()
+
+	game := New(WithProvablyFair(), WithDecks(2))
+	game.shuffleDeck()
+
+	r := game.Reveal()
+	if exp, got := 2*DeckSize, len(r.Order); exp != got {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+	if err := Verify(game.Commitment(), r, game.dealCards(4)); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestGame_SecureShuffle(t *testing.T) {
+	t.Parallel()
+
+	game := New(WithSecureShuffle())
+	game.shuffleDeck()
+
+	if !isValidDeck(game.deck.cards, t) {
+		t.Error("Expected a complete deck.")
+	}
+}
@@ -3,9 +3,11 @@ package holdem
 import (
 	"bytes"
 	"fmt"
-	"strings"
+	"sort"
 )
 
+//go:generate go run ./cmd/genlookups -o lookups.go
+
 type HandClass uint
 type HandValue uint32
 
@@ -31,6 +33,7 @@ const (
 	FullHouse
 	FourOfAKind
 	StraightFlush
+	FiveOfAKind // Only possible with more than one deck
 )
 
 const (
@@ -43,6 +46,7 @@ const (
 )
 
 const (
+	fiveOfAKindVal   = uint32(FiveOfAKind) << handTypeShift
 	straightFlushVal = uint32(StraightFlush) << handTypeShift
 	straightVal      = uint32(Straight) << handTypeShift
 	flushVal         = uint32(Flush) << handTypeShift
@@ -96,18 +100,28 @@ func NewHandCards(cards ...[]Card) Hand {
 	return hand
 }
 
-// NewHandStr creates a hand from the cards given.
+// NewHandStr creates a hand from the cards given. It panics if the string
+// does not parse; use ParseHand for untrusted input.
 func NewHandStr(h string) Hand {
-	var hand Hand
-
-	cards := strings.Split(h, " ")
-	for _, cardstr := range cards {
-		hand |= (1 << NewCardStr(cardstr))
+	hand, err := ParseHand(h)
+	if err != nil {
+		panic(err)
 	}
 
 	return hand
 }
 
+// ParseHand creates a hand from a list of cards separated by whitespace or
+// commas. See ParseCards for the accepted format.
+func ParseHand(h string) (Hand, error) {
+	cards, err := ParseCards(h)
+	if err != nil {
+		return 0, err
+	}
+
+	return NewHandCards(cards), nil
+}
+
 // Display computes the value of the hand and creates an output
 // string to display to a user with as well.
 func (h Hand) Display() (HandValue, string) {
@@ -151,6 +165,112 @@ func (h Hand) Display() (HandValue, string) {
 	return val, str
 }
 
+// Cards returns the cards in the hand, from the lowest to the highest.
+func (h Hand) Cards() []Card {
+	var cards []Card
+	for c := Card(0); c < numberOfCards; c++ {
+		if h&(1<<c) != 0 {
+			cards = append(cards, c)
+		}
+	}
+
+	return cards
+}
+
+// BestFive returns the cards that make up the value of the hand, in
+// canonical order: the biggest group of cards first, and the highest rank
+// first within groups of the same size. Straights run down from their top
+// card, so the ace of a wheel comes last. When several cards of a rank could
+// play, the higher suits are used. Hands of fewer than five cards return
+// all of them.
+func (h Hand) BestFive() []Card {
+	val := h.Value()
+
+	// Every rank in the value, and how many cards of it play.
+	var ranks [5]int
+	var counts []int
+	switch val.Class() {
+	case FourOfAKind:
+		counts = []int{4, 1}
+	case FullHouse:
+		counts = []int{3, 2}
+	case Trips:
+		counts = []int{3, 1, 1}
+	case TwoPair:
+		counts = []int{2, 2, 1}
+	case Pair:
+		counts = []int{2, 1, 1, 1}
+	default:
+		counts = []int{1, 1, 1, 1, 1}
+	}
+
+	switch val.Class() {
+	case Straight, StraightFlush:
+		for i := range ranks {
+			ranks[i] = (val.TopCard().Value() - i + 13) % 13
+		}
+	default:
+		ranks = [5]int{val.TopCard().Value(), val.SecondCard().Value(),
+			val.ThirdCard().Value(), val.FourthCard().Value(), val.FifthCard().Value()}
+	}
+
+	suits := []int{Spades, Hearts, Diamonds, Clubs}
+	if cls := val.Class(); cls == Flush || cls == StraightFlush {
+		suits = []int{h.flushSuit()}
+	}
+
+	var best []Card
+	used := h
+	for i, n := range counts {
+		for _, suit := range suits {
+			c := Card(ranks[i] + suit*13)
+			if n > 0 && used&(1<<c) != 0 {
+				best = append(best, c)
+				used &^= 1 << c
+				n--
+			}
+		}
+	}
+
+	return best
+}
+
+// flushSuit returns the suit with five or more cards in the hand, checked
+// in the same order as ValueCards.
+func (h Hand) flushSuit() int {
+	for _, suit := range []int{Spades, Clubs, Diamonds, Hearts} {
+		if nBitsTable[uint32(h>>(13*uint(suit)))&0x1FFF] >= 5 {
+			return suit
+		}
+	}
+
+	return -1
+}
+
+// DisplayCards renders the best five cards of the hand, with the hole cards
+// that played in brackets, e.g. "[A♠] K♠ Q♠ J♠ [10♠]".
+func (h Hand) DisplayCards(hole []Card) string {
+	var holeMask Hand
+	for _, c := range hole {
+		holeMask |= 1 << c
+	}
+
+	b := &bytes.Buffer{}
+	for i, c := range h.BestFive() {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+
+		if holeMask&(1<<c) != 0 {
+			fmt.Fprintf(b, "[%v]", c)
+		} else {
+			b.WriteString(c.String())
+		}
+	}
+
+	return b.String()
+}
+
 // String changes a HandValue into a readable representation.
 func (h HandValue) String() string {
 	b := &bytes.Buffer{}
@@ -168,13 +288,19 @@ func (h HandValue) String() string {
 	case Straight:
 		fmt.Fprintf(b, "Straight with %-v high", h.TopCard())
 	case Flush:
-		b.WriteString("Flush")
+		fmt.Fprintf(b, "Flush: %-v, %-v, %-v, %-v, %-v", h.TopCard(), h.SecondCard(), h.ThirdCard(), h.FourthCard(), h.FifthCard())
 	case FullHouse:
 		fmt.Fprintf(b, "Full house: %-v's and %-v's", h.TopCard(), h.SecondCard())
 	case FourOfAKind:
-		fmt.Fprintf(b, "Four of a kind: %-v", h.TopCard(), h.SecondCard())
+		fmt.Fprintf(b, "Four of a kind: %-v's with %-v kicker", h.TopCard(), h.SecondCard())
 	case StraightFlush:
-		b.WriteString("Straight Flush")
+		if h.TopCard().Value() == 12 {
+			b.WriteString("Royal flush")
+		} else {
+			fmt.Fprintf(b, "Straight flush with %-v high", h.TopCard())
+		}
+	case FiveOfAKind:
+		fmt.Fprintf(b, "Five of a kind: %-v's", h.TopCard())
 	}
 
 	return b.String()
@@ -182,7 +308,7 @@ func (h HandValue) String() string {
 
 // Class determines what class of hand this is.
 func (h HandValue) Class() HandClass {
-	return HandClass(h >> handTypeShift)
+	return HandClass(h>>handTypeShift) & 0xF
 }
 
 // TopCard determines the top card in the hand.
@@ -200,14 +326,68 @@ func (h HandValue) ThirdCard() Card {
 	return Card((uint64(h) >> thirdCardShift) & cardMask)
 }
 
+// FourthCard determines the fourth card in the hand.
+func (h HandValue) FourthCard() Card {
+	return Card((uint64(h) >> fourthCardShift) & cardMask)
+}
+
+// FifthCard determines the fifth card in the hand.
+func (h HandValue) FifthCard() Card {
+	return Card((uint64(h) >> fifthCardShift) & cardMask)
+}
+
+// Compare returns -1 if a loses to b, 1 if a beats b and 0 if they tie.
+// Values are totally ordered: two hands tie exactly when their values are
+// equal.
+func Compare(a, b HandValue) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+// Winners returns the names of the best hands, sorted by name. There is more
+// than one winner when the best hands tie.
+func Winners(hands map[string]Hand) []string {
+	var winners []string
+	var best HandValue
+	for name, hand := range hands {
+		switch v := hand.Value(); {
+		case len(winners) == 0 || v > best:
+			winners = []string{name}
+			best = v
+		case v == best:
+			winners = append(winners, name)
+		}
+	}
+	sort.Strings(winners)
+
+	return winners
+}
+
 // ValueCards computes the value of the hand. Determines automatically
 // how many cards exist.
 func (h Hand) Value() HandValue {
-	return h.ValueCards(countBits(uint64(h)))
+	n := countBits(uint64(h))
+	if perfectHash && n == 7 {
+		return h.Value7()
+	}
+
+	return h.ValueCards(n)
 }
 
 // ValueCards computes the value of the hand of size nCards.
 func (h Hand) ValueCards(nCards int) HandValue {
+	return h.valueCards(nCards, straightTable)
+}
+
+// valueCards computes the value of the hand, finding straights with
+// straights, which gives the top card of the best straight in a rank mask.
+func (h Hand) valueCards(nCards int, straights []uint16) HandValue {
 	var val, fourMask, threeMask, twoMask uint32
 
 	sc := uint32(h>>clubOffset) & 0x1FFF
@@ -222,33 +402,33 @@ func (h Hand) ValueCards(nCards int) HandValue {
 	if nValues >= 5 {
 		switch {
 		case nBitsTable[ss] >= 5:
-			if v := straightTable[ss]; v != 0 {
+			if v := straights[ss]; v != 0 {
 				val = straightFlushVal + (uint32(v) << topCardShift)
 			} else {
 				val = flushVal + topFiveCardsTable[ss]
 			}
 		case nBitsTable[sc] >= 5:
-			if v := straightTable[sc]; v != 0 {
+			if v := straights[sc]; v != 0 {
 				val = straightFlushVal + (uint32(v) << topCardShift)
 			} else {
 				val = flushVal + topFiveCardsTable[sc]
 			}
 		case nBitsTable[sd] >= 5:
-			if v := straightTable[sd]; v != 0 {
+			if v := straights[sd]; v != 0 {
 				val = straightFlushVal + (uint32(v) << topCardShift)
 			} else {
 				val = flushVal + topFiveCardsTable[sd]
 			}
 		case nBitsTable[sh] >= 5:
-			if v := straightTable[sh]; v != 0 {
+			if v := straights[sh]; v != 0 {
 				val = straightFlushVal + (uint32(v) << topCardShift)
 			} else {
 				val = flushVal + topFiveCardsTable[sh]
 			}
 		default:
-			st := straightTable[values]
+			st := straights[values]
 			if st != 0 {
-				val = straightVal + uint32(st<<topCardShift)
+				val = straightVal + (uint32(st) << topCardShift)
 			}
 		}
 
@@ -265,7 +445,7 @@ func (h Hand) ValueCards(nCards int) HandValue {
 
 		twoMask = values ^ (sc ^ sd ^ sh ^ ss)
 
-		val = pairVal + uint32(topCardTable[twoMask]<<topCardShift)
+		val = pairVal + (uint32(topCardTable[twoMask]) << topCardShift)
 		t = values ^ twoMask
 		kickers = (topFiveCardsTable[t] >> cardWidth) & ^fifthCardMask
 		val += kickers
@@ -286,7 +466,7 @@ func (h Hand) ValueCards(nCards int) HandValue {
 			second = uint32(topCardTable[t])
 			val += (second << secondCardShift)
 			t ^= (1 << second)
-			val += uint32(topCardTable[t] << thirdCardShift)
+			val += (uint32(topCardTable[t]) << thirdCardShift)
 			return HandValue(val)
 		}
 	default:
@@ -313,20 +493,18 @@ func (h Hand) ValueCards(nCards int) HandValue {
 
 		if val != 0 {
 			return HandValue(val)
-		} else {
-			var top, second uint32
-
-			val = twoPairVal
-			top = uint32(topCardTable[twoMask])
-			val += (top << topCardShift)
-			second = uint32(topCardTable[twoMask^(1<<top)])
-			val += (second << secondCardShift)
-			val += uint32(topCardTable[values^(1<<top)^(1<<second)]) << thirdCardShift
-			return HandValue(val)
 		}
-	}
 
-	return HandValue(val)
+		var top, second uint32
+
+		val = twoPairVal
+		top = uint32(topCardTable[twoMask])
+		val += (top << topCardShift)
+		second = uint32(topCardTable[twoMask^(1<<top)])
+		val += (second << secondCardShift)
+		val += uint32(topCardTable[values^(1<<top)^(1<<second)]) << thirdCardShift
+		return HandValue(val)
+	}
 }
 
 func countBits(bits uint64) int {
@@ -1,9 +1,31 @@
 package holdem
 
-import "testing"
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestHand_Value(t *testing.T) {
 	t.Parallel()
+
+	// Each hand beats the one after it.
+	hands := []string{
+		"5c 6d 7h 8s 9c 2d 2h",
+		"2c 3d 4h 5s 6c kd kh",
+		"ac ad 2h 3s 9c jd 7h",
+		"kc kd 2h 3s 9c jd 7h",
+		"kc kd 2h 3s 8c jd 7h",
+	}
+
+	for i := 1; i < len(hands); i++ {
+		a, b := NewHandStr(hands[i-1]).Value(), NewHandStr(hands[i]).Value()
+		if a <= b {
+			t.Errorf("Expected %s (%v) to beat %s (%v)", hands[i-1], a, hands[i], b)
+		}
+	}
 }
 
 func TestHand_New(t *testing.T) {
@@ -41,6 +63,22 @@ func TestHand_New(t *testing.T) {
 	}
 }
 
+func TestParseHand(t *testing.T) {
+	t.Parallel()
+
+	hand, err := ParseHand("2c,3d,Kh,5s,8d,Js,Qd")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if exp := NewHandStr("2c 3d kh 5s 8d js qd"); hand != exp {
+		t.Errorf("Expected: %d, got: %d", exp, hand)
+	}
+
+	if _, err := ParseHand("2c 3d 2C"); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Expected: %v, got: %v", ErrDuplicate, err)
+	}
+}
+
 func TestHand_Display(t *testing.T) {
 	t.Parallel()
 
@@ -226,3 +264,346 @@ func BenchmarkHand(b *testing.B) {
 		hand.ValueCards(7)
 	}
 }
+
+// referenceValue scores exactly five cards the slow and obvious way,
+// without any lookup tables: the class, then the distinct ranks ordered by
+// how often they appear and then by rank. Straights only keep their top
+// card.
+func referenceValue(cards []Card) HandValue {
+	var counts [13]int
+	flush := true
+	for _, c := range cards {
+		counts[c.Value()]++
+		flush = flush && c.Suit() == cards[0].Suit()
+	}
+
+	var ranks []int
+	for n := 4; n > 0; n-- {
+		for r := 12; r >= 0; r-- {
+			if counts[r] == n {
+				ranks = append(ranks, r)
+			}
+		}
+	}
+
+	straight := false
+	if len(ranks) == 5 {
+		switch {
+		case ranks[0]-ranks[4] == 4:
+			straight = true
+			ranks = ranks[:1]
+		case ranks[0] == 12 && ranks[1] == 3:
+			straight = true
+			ranks = []int{3}
+		}
+	}
+
+	var class HandClass
+	switch first := counts[ranks[0]]; {
+	case straight && flush:
+		class = StraightFlush
+	case first == 4:
+		class = FourOfAKind
+	case first == 3 && len(ranks) == 2:
+		class = FullHouse
+	case flush:
+		class = Flush
+	case straight:
+		class = Straight
+	case first == 3:
+		class = Trips
+	case len(ranks) == 3:
+		class = TwoPair
+	case first == 2:
+		class = Pair
+	}
+
+	val := uint32(class) << handTypeShift
+	for i, r := range ranks {
+		val |= uint32(r) << (topCardShift - cardWidth*uint(i))
+	}
+
+	return HandValue(val)
+}
+
+// referenceBest scores five to seven cards as the best referenceValue of
+// any five of them.
+func referenceBest(cards []Card) HandValue {
+	var best HandValue
+	eachFive(cards, func(five []Card) {
+		if v := referenceValue(five); v > best {
+			best = v
+		}
+	})
+
+	return best
+}
+
+// eachFive calls f with every five of cards, in a slice it reuses.
+func eachFive(cards []Card, f func(five []Card)) {
+	sub := make([]Card, 0, 5)
+
+	var walk func(i int)
+	walk = func(i int) {
+		if len(sub) == 5 {
+			f(sub)
+			return
+		}
+		for ; i <= len(cards)-(5-len(sub)); i++ {
+			sub = append(sub, cards[i])
+			walk(i + 1)
+			sub = sub[:len(sub)-1]
+		}
+	}
+	walk(0)
+}
+
+func TestHandValue_TotalOrder(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("Enumerates every five card hand")
+	}
+
+	// The reference breaks ties the same way, so matching it also shows
+	// the values are in order.
+	seen := make(map[HandValue]bool)
+	cards := make([]Card, 5)
+	for a := 0; a < 52; a++ {
+		for b := a + 1; b < 52; b++ {
+			for c := b + 1; c < 52; c++ {
+				for d := c + 1; d < 52; d++ {
+					for e := d + 1; e < 52; e++ {
+						cards[0], cards[1], cards[2], cards[3], cards[4] = Card(a), Card(b), Card(c), Card(d), Card(e)
+						exp := referenceValue(cards)
+						if got := NewHandCards(cards).ValueCards(5); got != exp {
+							t.Fatalf("%v: expected: %v (%x), got: %v (%x)", cards, exp, exp, got, got)
+						}
+						seen[exp] = true
+					}
+				}
+			}
+		}
+	}
+
+	if exp, got := 7462, len(seen); exp != got {
+		t.Errorf("Expected: %d distinct values, got: %d", exp, got)
+	}
+}
+
+func TestHandValue_Reference(t *testing.T) {
+	t.Parallel()
+
+	samples := 200000
+	if testing.Short() {
+		samples = 10000
+	}
+
+	deck := NewDeck(rand.NewSource(23))
+	for n := 0; n < samples; n++ {
+		size := 6 + n%2
+		deck.Reset()
+		deck.Shuffle()
+		cards := deck.Deal(size)
+
+		exp := referenceBest(cards)
+		if got := NewHandCards(cards).ValueCards(size); got != exp {
+			t.Fatalf("%v: expected: %v (%x), got: %v (%x)", cards, exp, exp, got, got)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	a := NewHandStr("as ks 2d 7c 9h").Value()
+	b := NewHandStr("ah kh 2c 7d 9s").Value()
+	c := NewHandStr("2s 2h 3d 4c 5h").Value()
+
+	if got := Compare(a, b); got != 0 {
+		t.Errorf("Expected: 0, got: %d", got)
+	}
+	if got := Compare(a, c); got != -1 {
+		t.Errorf("Expected: -1, got: %d", got)
+	}
+	if got := Compare(c, a); got != 1 {
+		t.Errorf("Expected: 1, got: %d", got)
+	}
+}
+
+func TestWinners(t *testing.T) {
+	t.Parallel()
+
+	board := "2c 7d 9h jc ks"
+	cases := []struct {
+		Hands  map[string]string
+		Expect []string
+	}{
+		{map[string]string{"A": "ac 3d", "B": "qc 3h"}, []string{"A"}},
+		{map[string]string{"A": "ac 3d", "B": "ad 4h", "C": "qc 3h"}, []string{"A", "B"}},
+		{map[string]string{"A": "3c 4d", "B": "3d 4h", "C": "3s 4s"}, []string{"A", "B", "C"}},
+		{map[string]string{"A": "kc kd", "B": "ah ad"}, []string{"A"}},
+		{map[string]string{}, nil},
+	}
+
+	for _, c := range cases {
+		hands := make(map[string]Hand)
+		for name, cards := range c.Hands {
+			hands[name] = NewHandStr(cards + " " + board)
+		}
+
+		if got := Winners(hands); !reflect.DeepEqual(got, c.Expect) {
+			t.Errorf("%v: expected: %v, got: %v", c.Hands, c.Expect, got)
+		}
+	}
+}
+
+func TestHandValue_String(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Cards  string
+		Expect string
+	}{
+		{"kc kd kh ks 2c", "Four of a kind: K's with 2 kicker"},
+		{"ad jd 9d 4d 2d", "Flush: A, J, 9, 4, 2"},
+		{"9h 10h jh qh kh", "Straight flush with K high"},
+		{"ah 2h 3h 4h 5h", "Straight flush with 5 high"},
+		{"10s js qs ks as", "Royal flush"},
+	}
+
+	for _, c := range cases {
+		if got := NewHandStr(c.Cards).Value().String(); got != c.Expect {
+			t.Errorf(`%s: expected: "%s", got: "%s"`, c.Cards, c.Expect, got)
+		}
+	}
+}
+
+func TestHand_BestFive(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Cards  string
+		Expect string
+	}{
+		{"as ks qs js 10s 9s 2d", "as ks qs js 10s"},
+		{"ah 2h 3h 4h 5h 6c kd", "5h 4h 3h 2h ah"},
+		{"5d 4c 3s 2h ad kd qd", "5d 4c 3s 2h ad"},
+		{"kc kd kh ks 2c 2d 9h", "ks kh kd kc 9h"},
+		{"kc kd kh 2s 2c 2d 9h", "kh kd kc 2s 2d"},
+		{"2d 5d 9d jd kd 3d 7c", "kd jd 9d 5d 3d"},
+		{"7c 7d 7h as 9h 2c 3d", "7h 7d 7c as 9h"},
+		{"7c 7d 9s 9h as 2c 2d", "9s 9h 7d 7c as"},
+		{"7c 7d 9s 2h as 3c 4d", "7d 7c as 9s 4d"},
+		{"7c jd 9s 2h as 3c 4d", "as jd 9s 7c 4d"},
+		{"ac ad", "ad ac"},
+	}
+
+	for _, c := range cases {
+		if got := NewHandStr(c.Cards).BestFive(); !reflect.DeepEqual(got, mustParseCards(t, c.Expect)) {
+			t.Errorf("%s: expected: %s, got: %v", c.Cards, c.Expect, got)
+		}
+	}
+}
+
+func TestHand_BestFiveValue(t *testing.T) {
+	t.Parallel()
+
+	deck := NewDeck(rand.NewSource(12))
+	for n := 0; n < 20000; n++ {
+		deck.Reset()
+		deck.Shuffle()
+		hand := NewHandCards(deck.Deal(7))
+
+		best := hand.BestFive()
+		five := NewHandCards(best)
+		if len(best) != 5 || five&hand != five {
+			t.Fatalf("%v: %v are not five cards of the hand", hand.Cards(), best)
+		}
+		if exp, got := hand.Value(), five.Value(); exp != got {
+			t.Fatalf("%v: expected: %v, got: %v from %v", hand.Cards(), exp, got, best)
+		}
+	}
+}
+
+func TestHand_DisplayCards(t *testing.T) {
+	t.Parallel()
+
+	hand := NewHandStr("as 10s ks qs js 2d 3c")
+	exp := "[A♠] K♠ Q♠ J♠ [10♠]"
+	if got := hand.DisplayCards(mustParseCards(t, "as 10s")); got != exp {
+		t.Errorf(`Expected: "%s", got: "%s"`, exp, got)
+	}
+}
+
+func mustParseCards(t *testing.T, str string) []Card {
+	cards, err := ParseCards(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cards
+}
+
+func FuzzHand(f *testing.F) {
+	f.Add(uint64(0x1F))
+	f.Add(uint64(0x100F))
+	f.Add(uint64(0x8004002001))
+	f.Add(uint64(0xFFFFFFFFFFFFF))
+
+	f.Fuzz(func(t *testing.T, mask uint64) {
+		h := Hand(mask & (1<<DeckSize - 1))
+		cards := h.Cards()
+		if len(cards) < 5 || len(cards) > 7 {
+			t.Skip()
+		}
+
+		exp := referenceBest(cards)
+		if got := h.ValueCards(len(cards)); got != exp {
+			t.Fatalf("%v: expected: %v (%x), got: %v (%x)", cards, exp, exp, got, got)
+		}
+		if got := referenceValue(h.BestFive()); got != exp {
+			t.Fatalf("%v: best five %v are worth %v, not %v", cards, h.BestFive(), got, exp)
+		}
+	})
+}
+
+func FuzzNewHandStr(f *testing.F) {
+	f.Add("as ks qs js 10s")
+	f.Add("2c,3d, 4h 5s 6c Td 9♠")
+	f.Add("ah ah")
+	f.Add("1s")
+
+	f.Fuzz(func(t *testing.T, str string) {
+		h, err := ParseHand(str)
+		if err != nil {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%q: expected NewHandStr to panic with: %v", str, err)
+				}
+			}()
+			NewHandStr(str)
+			return
+		}
+
+		if got := NewHandStr(str); got != h {
+			t.Fatalf("%q: expected: %x, got: %x", str, h, got)
+		}
+
+		// The cards printed back parse to the same hand.
+		cards := h.Cards()
+		strs := make([]string, len(cards))
+		for i, c := range cards {
+			strs[i] = c.String()
+		}
+		if got := NewHandStr(strings.Join(strs, " ")); got != h {
+			t.Fatalf("%q: expected: %x, got: %x", str, h, got)
+		}
+
+		if len(cards) >= 5 && len(cards) <= 7 {
+			if exp, got := referenceBest(cards), h.Value(); got != exp {
+				t.Fatalf("%q: expected: %v, got: %v", str, exp, got)
+			}
+		}
+	})
+}
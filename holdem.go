@@ -1,6 +1,10 @@
 package holdem
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"math/rand"
 	"time"
 )
@@ -9,312 +13,415 @@ type RoundStatus int
 type PlayerStatus int
 
 const (
-	Decks    = 1
+	Decks    = 1 // Default number of decks in a game
 	DeckSize = 52
 )
 
 const (
-	Flop RoundStatus = iota
+	PreFlop RoundStatus = iota
+	Flop
 	Turn
 	River
 
 	Folded PlayerStatus = iota // No longer in the round
-	Active                     // Participating in the round (checking, raised, all in)
+	Active                     // Participating in the round (checking, raised)
+	AllIn                      // Still in the round, but has no chips left to bet
 )
 
-var playerDB map[string]Player
+// Errors returned by AddPlayer.
+var (
+	ErrPlayerExists = errors.New("holdem: player already exists")
+	ErrTableFull    = errors.New("holdem: not enough cards to deal another player in")
+)
 
-type Callback func(game *Game, done chan bool)
+// Option configures a Game created by New.
+type Option func(*Game)
 
 type Game struct {
-	deck       []Card
+	deck       *Deck
+	decks      int
+	short      bool
+	fair       bool
+	reveal     Reveal
 	community  []Card
-	pot        uint32
 	currentBet uint32
+	minRaise   uint32 // Size of the last full bet or raise this street
+	raises     int    // Full bets and raises this street
+	street     RoundStatus
+	structure  BettingStructure
 
-	currentBetter *Player
-	// currentBetCompleted chan bool
+	actor   int       // Index of the player to act, -1 between betting rounds
 	players []*Player // Around the table in this round
+	button  int       // Index of the player on the button
 	frozen  bool
-
-	// blind uint32 // Might not require blinds in IRC gameplay
-
-	preRoundCallback  func(*Game, chan bool)
-	communityCallback func(RoundStatus, []Card)
-	/*
-		postFlopCallback  Callback
-		postTurnCallback  Callback
-		postRiverCallback Callback
-	*/
-
-	displayPlayerCardCallback func(string, []Card, chan bool)
-	betCallback               func(*Game, string)
+	playing bool // A hand is in progress
+
+	smallBlind   uint32
+	bigBlind     uint32
+	ante         uint32
+	bigBlindAnte bool
+	straddle     bool
+
+	holeCards   int
+	evaluate    Evaluator
+	variant     func(multi bool) Evaluator // Picks evaluate in New, once decks is known
+	evaluateLow LowEvaluator               // Only set for split games
+	awards      []Award
+
+	decider   Decider
+	observers []Observer
+
+	clock         Clock
+	actionTimeout time.Duration
+	timeWarning   time.Duration
+	timeBank      time.Duration
 }
 
 type Player struct {
 	// TODO: ID field for db?
 	// TODO: Replace player name with a generic pointer with user data instead
 	Name    string
-	Bet     uint32
+	Bet     uint32 // Chips bet on the current street
+	Total   uint32 // Chips put into the pot this hand
 	Status  PlayerStatus
 	Balance uint32
 
-	Hand []Card
-	// AllIn bool
+	Hand     []Card
+	TimeBank time.Duration // Extra time left to act, see WithTimeBank
+
+	acted bool // Has acted since the last bet or raise
+	left  bool // Left the table during a hand
 }
 
-func New() Game {
+func New(opts ...Option) Game {
 	g := Game{}
 
-	rand.Seed(time.Now().UnixNano())
+	for _, opt := range opts {
+		opt(&g)
+	}
+
+	if g.deck == nil {
+		g.deck = NewDeck(rand.NewSource(time.Now().UnixNano()))
+	}
+	if g.decks == 0 {
+		g.decks = Decks
+	}
+	g.deck.SetDecks(g.decks)
+	g.deck.SetShortDeck(g.short)
+
+	if g.clock == nil {
+		g.clock = realClock{}
+	}
+
+	if g.structure == nil {
+		g.structure = NoLimit{}
+	}
+
+	if g.holeCards == 0 {
+		g.holeCards = 2
+	}
 
-	g.createNewDeck()
+	if g.variant == nil {
+		g.variant = holdemVariant
+	}
+	if g.evaluate == nil {
+		g.evaluate = g.variant(g.decks > 1)
+	}
 	g.players = make([]*Player, 0, 2)
+	g.actor = -1
+	g.button = -1
 
 	return g
 }
 
-func (g *Game) SetPreRoundCallback(c func(*Game, chan bool)) {
-	g.preRoundCallback = c
+// WithRandSource makes the game shuffle with src. Seeding src with a fixed
+// value reproduces every hand dealt by the game.
+func WithRandSource(src rand.Source) Option {
+	return func(g *Game) {
+		g.deck = NewDeck(src)
+	}
 }
 
-func (g *Game) SetDisplayPlayerCardCallback(c func(string, []Card, chan bool)) {
-	g.displayPlayerCardCallback = c
+// WithDecks makes the game deal from n standard decks shuffled together.
+// Hands are then scored with MultiHand, which allows duplicate cards.
+func WithDecks(n int) Option {
+	return func(g *Game) {
+		g.decks = n
+	}
 }
 
-func (g *Game) SetBetCallback(c func(*Game, string)) {
-	g.betCallback = c
+// WithHoleCards makes the game deal n hole cards to every player.
+func WithHoleCards(n int) Option {
+	return func(g *Game) {
+		g.holeCards = n
+	}
 }
 
-func (g *Game) SetCommunityCallback(c func(RoundStatus, []Card)) {
-	g.communityCallback = c
+// WithEvaluator makes the game score hands at showdown with e.
+func WithEvaluator(e Evaluator) Option {
+	return func(g *Game) {
+		g.evaluate = e
+	}
 }
 
-func (g *Game) AddPlayer(name string) {
-	/*
-		if g.frozen {
-			return
-		}
-	*/
-
-	if _, exists := playerDB[name]; exists {
-		// TODO: error value?
-		// err = errors.New("Player already exists")
-		return
+// WithOmaha makes the game deal n hole cards, four for PLO4 and five for
+// PLO5, and score hands with EvaluateOmaha, or EvaluateOmahaMulti with
+// several decks. Combine it with PotLimit for pot-limit Omaha.
+func WithOmaha(n int) Option {
+	return func(g *Game) {
+		g.holeCards = n
+		g.evaluate = nil
+		g.variant = omahaVariant
 	}
+}
 
-	player := newPlayer(name)
-
-	if playerDB == nil {
-		playerDB = make(map[string]Player)
+// holdemVariant and omahaVariant pick the evaluator for a game, allowing
+// for duplicate cards when it has several decks.
+func holdemVariant(multi bool) Evaluator {
+	if multi {
+		return EvaluateMulti
 	}
-
-	playerDB[name] = player
-
-	g.players = append(g.players, &player)
-	// println("Appended", name, "len(g.players) ==", len(g.players))
+	return EvaluateHoldem
 }
 
-func (g *Game) JoinTable(name string) {
+func omahaVariant(multi bool) Evaluator {
+	if multi {
+		return EvaluateOmahaMulti
+	}
+	return EvaluateOmaha
 }
 
-func (g *Game) LeaveTable(name string) {
+// WithLowEvaluator makes the game a hi/lo split game, where the best low
+// hand scored by e takes half of every pot it qualifies for.
+func WithLowEvaluator(e LowEvaluator) Option {
+	return func(g *Game) {
+		g.evaluateLow = e
+	}
 }
 
-func newPlayer(name string) Player {
-	return Player{name, 0, 0, 100, nil} // TODO: Configurable initial balance
+// WithOmahaHiLo makes the game Omaha Hi/Lo eight-or-better with n hole
+// cards.
+func WithOmahaHiLo(n int) Option {
+	return func(g *Game) {
+		WithOmaha(n)(g)
+		g.evaluateLow = EvaluateOmahaEightOrBetter
+	}
 }
 
-func (g *Game) Play() {
-	g.newRound()    // Initiate the round
-	g.dealPreFlop() // 2 cards to each player
-
-	g.doBets()
-
-	g.dealFlop() // Deal 3 community cards
-	g.doBets()
-	g.dealTurn() // 4th community card
-	g.doBets()
-	g.dealRiver() // 5th community card
-	g.doBets()
+// WithRandReader makes the game shuffle with bytes read from r.
+func WithRandReader(r io.Reader) Option {
+	return func(g *Game) {
+		g.deck = NewDeckReader(r)
+	}
+}
 
+// AddPlayer seats a new player at the table.
+func (g *Game) AddPlayer(name string) error {
 	/*
-		//g.Showdown()
-
-		g.finishRound()
+		if g.frozen {
+			return
+		}
 	*/
-}
-
-func (g *Game) shuffleDeck() {
-	newDeck := make([]Card, Decks*DeckSize)
-	p := rand.Perm(Decks * DeckSize)
 
-	for i, k := range p {
-		newDeck[i] = g.deck[k]
+	if p, _ := g.player(name); p != nil {
+		return fmt.Errorf("%w: %q", ErrPlayerExists, name)
 	}
 
-	g.deck = newDeck
-}
-
-func (g *Game) shufflePlayers() {
-	if g.players != nil {
-		g.players = append(g.players[1:], g.players[0])
+	size := DeckSize
+	if g.short {
+		size = ShortDeckSize
+	}
+	if (len(g.players)+1)*g.holeCards+5 > g.decks*size {
+		return ErrTableFull
 	}
 
-	/*
-		newPlayers := make([]Player, len(g.players))
-		p := rand.Perm(len(g.players))
-
-		for i, k := range p {
-			newPlayers[i] = g.players[k]
-		}
-
-		g.players = newPlayers
-	*/
+	player := newPlayer(name)
+	player.TimeBank = g.timeBank
+	g.players = append(g.players, &player)
 
+	return nil
 }
 
-func (g *Game) createNewDeck() {
-	g.deck = make([]Card, Decks*DeckSize)
-
-	cards := 52 // cards in deck * suits
-	for i := 0; i < cards; i++ {
-		g.deck[i] = Card(i)
-	}
+// JoinTable seats a new player, who is dealt in from the next hand.
+func (g *Game) JoinTable(name string) error {
+	return g.AddPlayer(name)
 }
 
-func (g *Game) newRound() {
-	if g.preRoundCallback != nil {
-		done := make(chan bool)
-
-		g.frozen = false
-		g.currentBet = 0
+// LeaveTable takes a player off the table. A player leaving during a hand
+// folds, and their seat is freed when the hand is over.
+func (g *Game) LeaveTable(name string) error {
+	p, i := g.player(name)
+	if p == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownPlayer, name)
+	}
 
-		g.shuffleDeck()
+	if !g.playing {
+		g.removePlayer(i)
+		return nil
+	}
 
-		go g.preRoundCallback(g, done) // Players register for a new round (.hit)
-		// g.shufflePlayers()
+	p.left = true
+	if p.Status != Folded {
+		if g.ToAct() == name {
+			return g.Fold(name)
+		}
 
-		<-done
-		// g.frozen = true
+		p.Status = Folded
+		if g.actor >= 0 && g.inHand() < 2 {
+			g.actor = -1
+		}
 	}
-}
 
-func (g *Game) dealCard() (c Card) {
-	c = g.deck[0]
-	g.deck = g.deck[1:]
-	return
+	return nil
 }
 
-func (g *Game) currentBetterDone() {
-	// g.currentBetCompleted <- true
+func newPlayer(name string) Player {
+	return Player{Name: name, Status: Active, Balance: 100} // TODO: Configurable initial balance
 }
 
-func (g *Game) dealCards(n int) (c []Card) {
-	c = g.deck[:n]
-	g.deck = g.deck[n:]
-	return
+// Play deals a hand and plays it out, getting the players' actions from the
+// game's Decider and telling its observers what happens. It does nothing
+// unless at least two players with chips are seated.
+func (g *Game) Play() {
+	g.PlayContext(context.Background())
 }
 
-func (g *Game) isEndOfBets() bool {
-	for _, p := range g.players {
-		if p.Status == Folded {
-			continue
-		}
+// PlayContext is like Play, but once ctx is done every player still to act
+// is treated as having run out of time, so the hand ends quickly.
+func (g *Game) PlayContext(ctx context.Context) {
+	g.newRound() // Initiate the round
+	if !g.playing {
+		return
+	}
 
-		if p.Bet != g.currentBet {
-			println(p.Name, "had bet of", p.Bet, "current is", g.currentBet)
-			return false
-		}
+	g.dealPreFlop() // Hole cards to each player
+	g.startPreFlop()
+	g.doBets(ctx)
+
+	if g.inHand() > 1 {
+		g.dealFlop() // Deal 3 community cards
+		g.startBets(g.next(g.button))
+		g.doBets(ctx)
+	}
+	if g.inHand() > 1 {
+		g.dealTurn() // 4th community card
+		g.startBets(g.next(g.button))
+		g.doBets(ctx)
+	}
+	if g.inHand() > 1 {
+		g.dealRiver() // 5th community card
+		g.startBets(g.next(g.button))
+		g.doBets(ctx)
 	}
 
-	return true
+	g.finishRound()
 }
 
-func (g *Game) doBet() {
-	for _, player := range g.players {
-		if player.Status == Folded {
-			continue
-		}
-
-		g.currentBetter = player
-		g.betCallback(g, player.Name)
+func (g *Game) shuffleDeck() {
+	if g.fair {
+		g.shuffleFair()
+		return
 	}
+
+	g.deck.Reset()
+	g.deck.Shuffle()
 }
 
-func (g *Game) doBets() {
-	g.doBet()
+func (g *Game) newRound() {
+	g.frozen = false
+	g.currentBet = 0
+	g.actor = -1
+	g.community = nil
+	g.awards = nil
+
+	g.shuffleDeck()
+
+	// Players who left or went broke last hand lose their seats.
+	for i := len(g.players) - 1; i >= 0; i-- {
+		if p := g.players[i]; p.left || p.Balance == 0 {
+			g.removePlayer(i)
+		}
+	}
 
-	for !g.isEndOfBets() {
-		g.doBet()
+	if len(g.players) < 2 {
+		return
 	}
-}
 
-func (g *Game) Raise(player string, bet uint32) {
-	g.currentBet += bet
-	println(player, "raised bet to", g.currentBet)
-	g.currentBetter.Bet = g.currentBet
-	// go g.currentBetterDone()
-}
+	for _, p := range g.players {
+		p.Hand = nil
+		p.Bet = 0
+		p.Total = 0
+		p.Status = Active
+	}
 
-func (g *Game) Check(player string) {
-	println(player, "checked at", g.currentBet)
-	g.currentBetter.Bet = g.currentBet
+	g.playing = true
+	g.moveButton()
+	g.postAntes()
 
-	// go g.currentBetterDone()
+	e := HandStarted{Button: g.Button()}
+	for _, p := range g.seatOrder() {
+		e.Players = append(e.Players, p.Name)
+	}
+	if g.fair {
+		e.Commitment = g.Commitment()
+	}
+	g.emit(e)
 }
 
-func (g *Game) Fold(player string) {
-	println(player, "folded")
-	g.currentBetter.Status = Folded
-	// go g.currentBetterDone()
+func (g *Game) dealCard() Card {
+	return g.deck.Deal(1)[0]
 }
 
-func (g *Game) BetTimeout(player string) {
-	if player == g.currentBetter.Name {
-		g.currentBetter.Status = Folded
-		g.currentBetterDone()
-	}
+func (g *Game) dealCards(n int) []Card {
+	return g.deck.Deal(n)
 }
 
 func (g *Game) dealPreFlop() {
-	for i, p := range g.players {
-		// p.Hand = append(p.Hand, g.dealCards(2)...)
-		g.players[i].Hand = append(p.Hand, g.dealCards(2)...)
+	for _, p := range g.seatOrder() {
+		p.Hand = append(p.Hand, g.dealCards(g.holeCards)...)
 	}
 
-	c := make(chan bool)
-	for _, p := range g.players {
-		//println(p.Name, p.Hand)
-		go g.displayPlayerCardCallback(p.Name, p.Hand, c)
-		<-c
+	for _, p := range g.seatOrder() {
+		g.emit(HoleCardsDealt{p.Name, p.Hand})
 	}
-	// g.PostFlopCallback()
 }
 
 func (g *Game) dealFlop() {
-	g.community = append(g.community, g.dealCards(3)...)
-
-	// TODO: g.PostFlopCallback()
-
-	g.communityCallback(Flop, g.community)
+	g.street = Flop
+	g.dealStreet(g.dealCards(3))
 }
 
 func (g *Game) dealTurn() {
-	g.community = append(g.community, g.dealCard())
-
-	// TODO: g.PostTurnCallback()
-	g.communityCallback(Turn, g.community)
+	g.street = Turn
+	g.dealStreet([]Card{g.dealCard()})
 }
 
 func (g *Game) dealRiver() {
-	g.community = append(g.community, g.dealCard())
+	g.street = River
+	g.dealStreet([]Card{g.dealCard()})
+}
 
-	// TODO: g.PostRiverCallback()
-	g.communityCallback(River, g.community)
+func (g *Game) dealStreet(cards []Card) {
+	g.community = append(g.community, cards...)
+	g.emit(StreetDealt{g.street, cards, g.community})
 }
 
 func (g *Game) finishRound() {
-	// TODO: g.updateBalanceCallback(player, amount)
-	// TODO: g.endOfRoundCallback()
+	g.awards = g.showdown()
+	g.playing = false
+
+	for _, p := range g.players {
+		p.Bet = 0
+		p.Total = 0
+	}
+
+	for _, a := range g.awards {
+		g.emit(PotAwarded{a})
+	}
+
+	e := HandEnded{Awards: g.awards}
+	if g.fair {
+		e.Reveal = g.Reveal()
+	}
+	g.emit(e)
 }
@@ -1,6 +1,8 @@
 package holdem
 
 import (
+	"errors"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,11 +32,11 @@ func isValidDeck(deck []Card, t *testing.T) bool {
 func TestInit(t *testing.T) {
 	game := New()
 
-	assert.NotEmpty(t, game.deck)
-	assert.Equal(t, Decks*DeckSize, len(game.deck))
+	assert.NotEmpty(t, game.deck.cards)
+	assert.Equal(t, Decks*DeckSize, game.deck.Remaining())
 
 	valid := true
-	for _, v := range game.deck {
+	for _, v := range game.deck.cards {
 		if !isValidCard(v) {
 
 			assert.Fail(t, "Invalid card")
@@ -45,24 +47,31 @@ func TestInit(t *testing.T) {
 	}
 
 	assert.True(t, valid)
-	assert.True(t, isValidDeck(game.deck, t))
+	assert.True(t, isValidDeck(game.deck.cards, t))
+}
+
+func TestInitDecks(t *testing.T) {
+	game := New(WithDecks(2), WithRandSource(rand.NewSource(1)))
+
+	assert.Equal(t, 2, game.deck.Decks())
+	assert.Equal(t, 2*DeckSize, game.deck.Remaining())
 }
 
 func TestShuffle(t *testing.T) {
 	game := New()
 
 	game.shuffleDeck()
-	assert.True(t, isValidDeck(game.deck, t))
+	assert.True(t, isValidDeck(game.deck.cards, t))
 
 	old := make([]Card, Decks*DeckSize)
-	copy(old, game.deck)
+	copy(old, game.deck.cards)
 
 	game.shuffleDeck()
-	assert.True(t, isValidDeck(game.deck, t))
+	assert.True(t, isValidDeck(game.deck.cards, t))
 
 	equal := true
 	for i, v := range old {
-		if v != game.deck[i] {
+		if v != game.deck.cards[i] {
 			equal = false
 			break
 		}
@@ -71,37 +80,40 @@ func TestShuffle(t *testing.T) {
 	assert.False(t, equal)
 }
 
+func TestSeededShuffle(t *testing.T) {
+	deal := func() [][]Card {
+		game := New(WithRandSource(rand.NewSource(42)))
+		game.shuffleDeck()
+
+		return [][]Card{game.dealCards(2), game.dealCards(2), game.dealCards(5)}
+	}
+
+	assert.Equal(t, deal(), deal())
+}
+
 func TestDeal(t *testing.T) {
 
 }
 
 func TestPreRound(t *testing.T) {
-	t.SkipNow()
 	game := New()
 
-	game.SetPreRoundCallback(func(g *Game, done chan bool) {
-		g.AddPlayer("A")
-		g.AddPlayer("B")
-
-		// done <- true
-	})
+	game.AddPlayer("A")
+	game.AddPlayer("B")
 
 	game.Play()
 
 	assert.Equal(t, 2, len(game.players))
+	assert.Equal(t, 5, len(game.community))
 }
 
 func TestDeals(t *testing.T) {
-	t.SkipNow()
 	game := New()
 
-	game.SetPreRoundCallback(func(g *Game, done chan bool) {
-		g.AddPlayer("A")
-		g.AddPlayer("B")
-
-		done <- true
-	})
+	game.AddPlayer("A")
+	game.AddPlayer("B")
 
+	game.newRound()
 	game.dealPreFlop()
 
 	for _, p := range game.players {
@@ -117,23 +129,55 @@ func TestDeals(t *testing.T) {
 	assert.True(t, len(game.community) == 5)
 }
 
-func TestBettingPlayerCanBet(t *testing.T) {
-	return // Test disabled
+func TestDealsOmaha(t *testing.T) {
+	for _, n := range []int{4, 5, 6} {
+		game := New(WithOmaha(n), WithRandSource(rand.NewSource(int64(n))))
 
-	game := New()
+		game.AddPlayer("A")
+		game.AddPlayer("B")
+		game.AddPlayer("C")
 
-	game.SetPreRoundCallback(func(g *Game, done chan bool) {
-		g.AddPlayer("A")
-		g.AddPlayer("B")
+		game.Play()
+		for _, p := range game.players {
+			assert.Equal(t, n, len(p.Hand))
+		}
+		assert.Equal(t, 5, len(game.community))
+	}
+}
 
-		// done <- true
-	})
+func TestTableFull(t *testing.T) {
+	// Six hole cards each and a board leave cards for seven players.
+	game := New(WithOmaha(6))
+	for _, name := range []string{"A", "B", "C", "D", "E", "F", "G"} {
+		assert.NoError(t, game.AddPlayer(name))
+	}
+	err := game.AddPlayer("H")
+	assert.True(t, errors.Is(err, ErrTableFull), err)
 
-	game.dealPreFlop()
+	// Two decks seat more, and score hands with duplicate cards.
+	game = New(WithOmaha(6), WithDecks(2))
+	for _, name := range []string{"A", "B", "C", "D", "E", "F", "G", "H"} {
+		assert.NoError(t, game.AddPlayer(name))
+	}
+	hole, board := []Card{NewCardStr("as"), NewCardStr("ks"), NewCardStr("2c"), NewCardStr("3d")},
+		[]Card{NewCardStr("as"), NewCardStr("qs"), NewCardStr("js"), NewCardStr("7h"), NewCardStr("8c")}
+	assert.Equal(t, Flush, game.evaluate(hole, board).Class())
+
+	// An evaluator given after WithOmaha still wins.
+	game = New(WithOmaha(4), WithDecks(2), WithEvaluator(EvaluateOmaha))
+	assert.Equal(t, Pair, game.evaluate(hole, board).Class())
+}
+
+func TestBettingPlayerCanBet(t *testing.T) {
+	game := New()
 
-	game.doBets()
+	assert.NoError(t, game.AddPlayer("A"))
+	assert.NoError(t, game.AddPlayer("B"))
+	assert.Error(t, game.AddPlayer("A"))
 
-	assert.True(t, game.currentBetter.Name == "A")
+	game.startBets(0)
 
-	game.Check("A")
+	assert.True(t, game.ToAct() == "A")
+	assert.NoError(t, game.Check("A"))
+	assert.True(t, game.ToAct() == "B")
 }
@@ -1,3 +1,5 @@
+// Code generated by cmd/genlookups; DO NOT EDIT.
+
 package holdem
 
 var bitCounts = []byte{
@@ -4143,3 +4145,2058 @@ var cardMasksTable = []uint64{
 	0x800000000000, 0x1000000000000, 0x2000000000000, 0x4000000000000,
 	0x8000000000000,
 }
+
+var bottomFiveCardsTable = []uint32{
+	0x00000000, 0x00000000, 0x00010000, 0x00010000, 0x00020000, 0x00020000,
+	0x00021000, 0x00021000, 0x00030000, 0x00030000, 0x00031000, 0x00031000,
+	0x00032000, 0x00032000, 0x00032100, 0x00032100, 0x00040000, 0x00040000,
+	0x00041000, 0x00041000, 0x00042000, 0x00042000, 0x00042100, 0x00042100,
+	0x00043000, 0x00043000, 0x00043100, 0x00043100, 0x00043200, 0x00043200,
+	0x00043210, 0x00043210, 0x00050000, 0x00050000, 0x00051000, 0x00051000,
+	0x00052000, 0x00052000, 0x00052100, 0x00052100, 0x00053000, 0x00053000,
+	0x00053100, 0x00053100, 0x00053200, 0x00053200, 0x00053210, 0x00053210,
+	0x00054000, 0x00054000, 0x00054100, 0x00054100, 0x00054200, 0x00054200,
+	0x00054210, 0x00054210, 0x00054300, 0x00054300, 0x00054310, 0x00054310,
+	0x00054320, 0x00054320, 0x00054321, 0x00043210, 0x00060000, 0x00060000,
+	0x00061000, 0x00061000, 0x00062000, 0x00062000, 0x00062100, 0x00062100,
+	0x00063000, 0x00063000, 0x00063100, 0x00063100, 0x00063200, 0x00063200,
+	0x00063210, 0x00063210, 0x00064000, 0x00064000, 0x00064100, 0x00064100,
+	0x00064200, 0x00064200, 0x00064210, 0x00064210, 0x00064300, 0x00064300,
+	0x00064310, 0x00064310, 0x00064320, 0x00064320, 0x00064321, 0x00043210,
+	0x00065000, 0x00065000, 0x00065100, 0x00065100, 0x00065200, 0x00065200,
+	0x00065210, 0x00065210, 0x00065300, 0x00065300, 0x00065310, 0x00065310,
+	0x00065320, 0x00065320, 0x00065321, 0x00053210, 0x00065400, 0x00065400,
+	0x00065410, 0x00065410, 0x00065420, 0x00065420, 0x00065421, 0x00054210,
+	0x00065430, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x00070000, 0x00070000, 0x00071000, 0x00071000,
+	0x00072000, 0x00072000, 0x00072100, 0x00072100, 0x00073000, 0x00073000,
+	0x00073100, 0x00073100, 0x00073200, 0x00073200, 0x00073210, 0x00073210,
+	0x00074000, 0x00074000, 0x00074100, 0x00074100, 0x00074200, 0x00074200,
+	0x00074210, 0x00074210, 0x00074300, 0x00074300, 0x00074310, 0x00074310,
+	0x00074320, 0x00074320, 0x00074321, 0x00043210, 0x00075000, 0x00075000,
+	0x00075100, 0x00075100, 0x00075200, 0x00075200, 0x00075210, 0x00075210,
+	0x00075300, 0x00075300, 0x00075310, 0x00075310, 0x00075320, 0x00075320,
+	0x00075321, 0x00053210, 0x00075400, 0x00075400, 0x00075410, 0x00075410,
+	0x00075420, 0x00075420, 0x00075421, 0x00054210, 0x00075430, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x00076000, 0x00076000, 0x00076100, 0x00076100, 0x00076200, 0x00076200,
+	0x00076210, 0x00076210, 0x00076300, 0x00076300, 0x00076310, 0x00076310,
+	0x00076320, 0x00076320, 0x00076321, 0x00063210, 0x00076400, 0x00076400,
+	0x00076410, 0x00076410, 0x00076420, 0x00076420, 0x00076421, 0x00064210,
+	0x00076430, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x00076500, 0x00076500, 0x00076510, 0x00076510,
+	0x00076520, 0x00076520, 0x00076521, 0x00065210, 0x00076530, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x00076540, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x00080000, 0x00080000,
+	0x00081000, 0x00081000, 0x00082000, 0x00082000, 0x00082100, 0x00082100,
+	0x00083000, 0x00083000, 0x00083100, 0x00083100, 0x00083200, 0x00083200,
+	0x00083210, 0x00083210, 0x00084000, 0x00084000, 0x00084100, 0x00084100,
+	0x00084200, 0x00084200, 0x00084210, 0x00084210, 0x00084300, 0x00084300,
+	0x00084310, 0x00084310, 0x00084320, 0x00084320, 0x00084321, 0x00043210,
+	0x00085000, 0x00085000, 0x00085100, 0x00085100, 0x00085200, 0x00085200,
+	0x00085210, 0x00085210, 0x00085300, 0x00085300, 0x00085310, 0x00085310,
+	0x00085320, 0x00085320, 0x00085321, 0x00053210, 0x00085400, 0x00085400,
+	0x00085410, 0x00085410, 0x00085420, 0x00085420, 0x00085421, 0x00054210,
+	0x00085430, 0x00085430, 0x00085431, 0x00054310, 0x00085432, 0x00054320,
+	0x00054321, 0x00043210, 0x00086000, 0x00086000, 0x00086100, 0x00086100,
+	0x00086200, 0x00086200, 0x00086210, 0x00086210, 0x00086300, 0x00086300,
+	0x00086310, 0x00086310, 0x00086320, 0x00086320, 0x00086321, 0x00063210,
+	0x00086400, 0x00086400, 0x00086410, 0x00086410, 0x00086420, 0x00086420,
+	0x00086421, 0x00064210, 0x00086430, 0x00086430, 0x00086431, 0x00064310,
+	0x00086432, 0x00064320, 0x00064321, 0x00043210, 0x00086500, 0x00086500,
+	0x00086510, 0x00086510, 0x00086520, 0x00086520, 0x00086521, 0x00065210,
+	0x00086530, 0x00086530, 0x00086531, 0x00065310, 0x00086532, 0x00065320,
+	0x00065321, 0x00053210, 0x00086540, 0x00086540, 0x00086541, 0x00065410,
+	0x00086542, 0x00065420, 0x00065421, 0x00054210, 0x00086543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x00087000, 0x00087000, 0x00087100, 0x00087100, 0x00087200, 0x00087200,
+	0x00087210, 0x00087210, 0x00087300, 0x00087300, 0x00087310, 0x00087310,
+	0x00087320, 0x00087320, 0x00087321, 0x00073210, 0x00087400, 0x00087400,
+	0x00087410, 0x00087410, 0x00087420, 0x00087420, 0x00087421, 0x00074210,
+	0x00087430, 0x00087430, 0x00087431, 0x00074310, 0x00087432, 0x00074320,
+	0x00074321, 0x00043210, 0x00087500, 0x00087500, 0x00087510, 0x00087510,
+	0x00087520, 0x00087520, 0x00087521, 0x00075210, 0x00087530, 0x00087530,
+	0x00087531, 0x00075310, 0x00087532, 0x00075320, 0x00075321, 0x00053210,
+	0x00087540, 0x00087540, 0x00087541, 0x00075410, 0x00087542, 0x00075420,
+	0x00075421, 0x00054210, 0x00087543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x00087600, 0x00087600,
+	0x00087610, 0x00087610, 0x00087620, 0x00087620, 0x00087621, 0x00076210,
+	0x00087630, 0x00087630, 0x00087631, 0x00076310, 0x00087632, 0x00076320,
+	0x00076321, 0x00063210, 0x00087640, 0x00087640, 0x00087641, 0x00076410,
+	0x00087642, 0x00076420, 0x00076421, 0x00064210, 0x00087643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x00087650, 0x00087650, 0x00087651, 0x00076510, 0x00087652, 0x00076520,
+	0x00076521, 0x00065210, 0x00087653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x00087654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x00090000, 0x00090000, 0x00091000, 0x00091000,
+	0x00092000, 0x00092000, 0x00092100, 0x00092100, 0x00093000, 0x00093000,
+	0x00093100, 0x00093100, 0x00093200, 0x00093200, 0x00093210, 0x00093210,
+	0x00094000, 0x00094000, 0x00094100, 0x00094100, 0x00094200, 0x00094200,
+	0x00094210, 0x00094210, 0x00094300, 0x00094300, 0x00094310, 0x00094310,
+	0x00094320, 0x00094320, 0x00094321, 0x00043210, 0x00095000, 0x00095000,
+	0x00095100, 0x00095100, 0x00095200, 0x00095200, 0x00095210, 0x00095210,
+	0x00095300, 0x00095300, 0x00095310, 0x00095310, 0x00095320, 0x00095320,
+	0x00095321, 0x00053210, 0x00095400, 0x00095400, 0x00095410, 0x00095410,
+	0x00095420, 0x00095420, 0x00095421, 0x00054210, 0x00095430, 0x00095430,
+	0x00095431, 0x00054310, 0x00095432, 0x00054320, 0x00054321, 0x00043210,
+	0x00096000, 0x00096000, 0x00096100, 0x00096100, 0x00096200, 0x00096200,
+	0x00096210, 0x00096210, 0x00096300, 0x00096300, 0x00096310, 0x00096310,
+	0x00096320, 0x00096320, 0x00096321, 0x00063210, 0x00096400, 0x00096400,
+	0x00096410, 0x00096410, 0x00096420, 0x00096420, 0x00096421, 0x00064210,
+	0x00096430, 0x00096430, 0x00096431, 0x00064310, 0x00096432, 0x00064320,
+	0x00064321, 0x00043210, 0x00096500, 0x00096500, 0x00096510, 0x00096510,
+	0x00096520, 0x00096520, 0x00096521, 0x00065210, 0x00096530, 0x00096530,
+	0x00096531, 0x00065310, 0x00096532, 0x00065320, 0x00065321, 0x00053210,
+	0x00096540, 0x00096540, 0x00096541, 0x00065410, 0x00096542, 0x00065420,
+	0x00065421, 0x00054210, 0x00096543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x00097000, 0x00097000,
+	0x00097100, 0x00097100, 0x00097200, 0x00097200, 0x00097210, 0x00097210,
+	0x00097300, 0x00097300, 0x00097310, 0x00097310, 0x00097320, 0x00097320,
+	0x00097321, 0x00073210, 0x00097400, 0x00097400, 0x00097410, 0x00097410,
+	0x00097420, 0x00097420, 0x00097421, 0x00074210, 0x00097430, 0x00097430,
+	0x00097431, 0x00074310, 0x00097432, 0x00074320, 0x00074321, 0x00043210,
+	0x00097500, 0x00097500, 0x00097510, 0x00097510, 0x00097520, 0x00097520,
+	0x00097521, 0x00075210, 0x00097530, 0x00097530, 0x00097531, 0x00075310,
+	0x00097532, 0x00075320, 0x00075321, 0x00053210, 0x00097540, 0x00097540,
+	0x00097541, 0x00075410, 0x00097542, 0x00075420, 0x00075421, 0x00054210,
+	0x00097543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x00097600, 0x00097600, 0x00097610, 0x00097610,
+	0x00097620, 0x00097620, 0x00097621, 0x00076210, 0x00097630, 0x00097630,
+	0x00097631, 0x00076310, 0x00097632, 0x00076320, 0x00076321, 0x00063210,
+	0x00097640, 0x00097640, 0x00097641, 0x00076410, 0x00097642, 0x00076420,
+	0x00076421, 0x00064210, 0x00097643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x00097650, 0x00097650,
+	0x00097651, 0x00076510, 0x00097652, 0x00076520, 0x00076521, 0x00065210,
+	0x00097653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x00097654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x00098000, 0x00098000, 0x00098100, 0x00098100, 0x00098200, 0x00098200,
+	0x00098210, 0x00098210, 0x00098300, 0x00098300, 0x00098310, 0x00098310,
+	0x00098320, 0x00098320, 0x00098321, 0x00083210, 0x00098400, 0x00098400,
+	0x00098410, 0x00098410, 0x00098420, 0x00098420, 0x00098421, 0x00084210,
+	0x00098430, 0x00098430, 0x00098431, 0x00084310, 0x00098432, 0x00084320,
+	0x00084321, 0x00043210, 0x00098500, 0x00098500, 0x00098510, 0x00098510,
+	0x00098520, 0x00098520, 0x00098521, 0x00085210, 0x00098530, 0x00098530,
+	0x00098531, 0x00085310, 0x00098532, 0x00085320, 0x00085321, 0x00053210,
+	0x00098540, 0x00098540, 0x00098541, 0x00085410, 0x00098542, 0x00085420,
+	0x00085421, 0x00054210, 0x00098543, 0x00085430, 0x00085431, 0x00054310,
+	0x00085432, 0x00054320, 0x00054321, 0x00043210, 0x00098600, 0x00098600,
+	0x00098610, 0x00098610, 0x00098620, 0x00098620, 0x00098621, 0x00086210,
+	0x00098630, 0x00098630, 0x00098631, 0x00086310, 0x00098632, 0x00086320,
+	0x00086321, 0x00063210, 0x00098640, 0x00098640, 0x00098641, 0x00086410,
+	0x00098642, 0x00086420, 0x00086421, 0x00064210, 0x00098643, 0x00086430,
+	0x00086431, 0x00064310, 0x00086432, 0x00064320, 0x00064321, 0x00043210,
+	0x00098650, 0x00098650, 0x00098651, 0x00086510, 0x00098652, 0x00086520,
+	0x00086521, 0x00065210, 0x00098653, 0x00086530, 0x00086531, 0x00065310,
+	0x00086532, 0x00065320, 0x00065321, 0x00053210, 0x00098654, 0x00086540,
+	0x00086541, 0x00065410, 0x00086542, 0x00065420, 0x00065421, 0x00054210,
+	0x00086543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x00098700, 0x00098700, 0x00098710, 0x00098710,
+	0x00098720, 0x00098720, 0x00098721, 0x00087210, 0x00098730, 0x00098730,
+	0x00098731, 0x00087310, 0x00098732, 0x00087320, 0x00087321, 0x00073210,
+	0x00098740, 0x00098740, 0x00098741, 0x00087410, 0x00098742, 0x00087420,
+	0x00087421, 0x00074210, 0x00098743, 0x00087430, 0x00087431, 0x00074310,
+	0x00087432, 0x00074320, 0x00074321, 0x00043210, 0x00098750, 0x00098750,
+	0x00098751, 0x00087510, 0x00098752, 0x00087520, 0x00087521, 0x00075210,
+	0x00098753, 0x00087530, 0x00087531, 0x00075310, 0x00087532, 0x00075320,
+	0x00075321, 0x00053210, 0x00098754, 0x00087540, 0x00087541, 0x00075410,
+	0x00087542, 0x00075420, 0x00075421, 0x00054210, 0x00087543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x00098760, 0x00098760, 0x00098761, 0x00087610, 0x00098762, 0x00087620,
+	0x00087621, 0x00076210, 0x00098763, 0x00087630, 0x00087631, 0x00076310,
+	0x00087632, 0x00076320, 0x00076321, 0x00063210, 0x00098764, 0x00087640,
+	0x00087641, 0x00076410, 0x00087642, 0x00076420, 0x00076421, 0x00064210,
+	0x00087643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x00098765, 0x00087650, 0x00087651, 0x00076510,
+	0x00087652, 0x00076520, 0x00076521, 0x00065210, 0x00087653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x00087654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000a0000, 0x000a0000,
+	0x000a1000, 0x000a1000, 0x000a2000, 0x000a2000, 0x000a2100, 0x000a2100,
+	0x000a3000, 0x000a3000, 0x000a3100, 0x000a3100, 0x000a3200, 0x000a3200,
+	0x000a3210, 0x000a3210, 0x000a4000, 0x000a4000, 0x000a4100, 0x000a4100,
+	0x000a4200, 0x000a4200, 0x000a4210, 0x000a4210, 0x000a4300, 0x000a4300,
+	0x000a4310, 0x000a4310, 0x000a4320, 0x000a4320, 0x000a4321, 0x00043210,
+	0x000a5000, 0x000a5000, 0x000a5100, 0x000a5100, 0x000a5200, 0x000a5200,
+	0x000a5210, 0x000a5210, 0x000a5300, 0x000a5300, 0x000a5310, 0x000a5310,
+	0x000a5320, 0x000a5320, 0x000a5321, 0x00053210, 0x000a5400, 0x000a5400,
+	0x000a5410, 0x000a5410, 0x000a5420, 0x000a5420, 0x000a5421, 0x00054210,
+	0x000a5430, 0x000a5430, 0x000a5431, 0x00054310, 0x000a5432, 0x00054320,
+	0x00054321, 0x00043210, 0x000a6000, 0x000a6000, 0x000a6100, 0x000a6100,
+	0x000a6200, 0x000a6200, 0x000a6210, 0x000a6210, 0x000a6300, 0x000a6300,
+	0x000a6310, 0x000a6310, 0x000a6320, 0x000a6320, 0x000a6321, 0x00063210,
+	0x000a6400, 0x000a6400, 0x000a6410, 0x000a6410, 0x000a6420, 0x000a6420,
+	0x000a6421, 0x00064210, 0x000a6430, 0x000a6430, 0x000a6431, 0x00064310,
+	0x000a6432, 0x00064320, 0x00064321, 0x00043210, 0x000a6500, 0x000a6500,
+	0x000a6510, 0x000a6510, 0x000a6520, 0x000a6520, 0x000a6521, 0x00065210,
+	0x000a6530, 0x000a6530, 0x000a6531, 0x00065310, 0x000a6532, 0x00065320,
+	0x00065321, 0x00053210, 0x000a6540, 0x000a6540, 0x000a6541, 0x00065410,
+	0x000a6542, 0x00065420, 0x00065421, 0x00054210, 0x000a6543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000a7000, 0x000a7000, 0x000a7100, 0x000a7100, 0x000a7200, 0x000a7200,
+	0x000a7210, 0x000a7210, 0x000a7300, 0x000a7300, 0x000a7310, 0x000a7310,
+	0x000a7320, 0x000a7320, 0x000a7321, 0x00073210, 0x000a7400, 0x000a7400,
+	0x000a7410, 0x000a7410, 0x000a7420, 0x000a7420, 0x000a7421, 0x00074210,
+	0x000a7430, 0x000a7430, 0x000a7431, 0x00074310, 0x000a7432, 0x00074320,
+	0x00074321, 0x00043210, 0x000a7500, 0x000a7500, 0x000a7510, 0x000a7510,
+	0x000a7520, 0x000a7520, 0x000a7521, 0x00075210, 0x000a7530, 0x000a7530,
+	0x000a7531, 0x00075310, 0x000a7532, 0x00075320, 0x00075321, 0x00053210,
+	0x000a7540, 0x000a7540, 0x000a7541, 0x00075410, 0x000a7542, 0x00075420,
+	0x00075421, 0x00054210, 0x000a7543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000a7600, 0x000a7600,
+	0x000a7610, 0x000a7610, 0x000a7620, 0x000a7620, 0x000a7621, 0x00076210,
+	0x000a7630, 0x000a7630, 0x000a7631, 0x00076310, 0x000a7632, 0x00076320,
+	0x00076321, 0x00063210, 0x000a7640, 0x000a7640, 0x000a7641, 0x00076410,
+	0x000a7642, 0x00076420, 0x00076421, 0x00064210, 0x000a7643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x000a7650, 0x000a7650, 0x000a7651, 0x00076510, 0x000a7652, 0x00076520,
+	0x00076521, 0x00065210, 0x000a7653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x000a7654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000a8000, 0x000a8000, 0x000a8100, 0x000a8100,
+	0x000a8200, 0x000a8200, 0x000a8210, 0x000a8210, 0x000a8300, 0x000a8300,
+	0x000a8310, 0x000a8310, 0x000a8320, 0x000a8320, 0x000a8321, 0x00083210,
+	0x000a8400, 0x000a8400, 0x000a8410, 0x000a8410, 0x000a8420, 0x000a8420,
+	0x000a8421, 0x00084210, 0x000a8430, 0x000a8430, 0x000a8431, 0x00084310,
+	0x000a8432, 0x00084320, 0x00084321, 0x00043210, 0x000a8500, 0x000a8500,
+	0x000a8510, 0x000a8510, 0x000a8520, 0x000a8520, 0x000a8521, 0x00085210,
+	0x000a8530, 0x000a8530, 0x000a8531, 0x00085310, 0x000a8532, 0x00085320,
+	0x00085321, 0x00053210, 0x000a8540, 0x000a8540, 0x000a8541, 0x00085410,
+	0x000a8542, 0x00085420, 0x00085421, 0x00054210, 0x000a8543, 0x00085430,
+	0x00085431, 0x00054310, 0x00085432, 0x00054320, 0x00054321, 0x00043210,
+	0x000a8600, 0x000a8600, 0x000a8610, 0x000a8610, 0x000a8620, 0x000a8620,
+	0x000a8621, 0x00086210, 0x000a8630, 0x000a8630, 0x000a8631, 0x00086310,
+	0x000a8632, 0x00086320, 0x00086321, 0x00063210, 0x000a8640, 0x000a8640,
+	0x000a8641, 0x00086410, 0x000a8642, 0x00086420, 0x00086421, 0x00064210,
+	0x000a8643, 0x00086430, 0x00086431, 0x00064310, 0x00086432, 0x00064320,
+	0x00064321, 0x00043210, 0x000a8650, 0x000a8650, 0x000a8651, 0x00086510,
+	0x000a8652, 0x00086520, 0x00086521, 0x00065210, 0x000a8653, 0x00086530,
+	0x00086531, 0x00065310, 0x00086532, 0x00065320, 0x00065321, 0x00053210,
+	0x000a8654, 0x00086540, 0x00086541, 0x00065410, 0x00086542, 0x00065420,
+	0x00065421, 0x00054210, 0x00086543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000a8700, 0x000a8700,
+	0x000a8710, 0x000a8710, 0x000a8720, 0x000a8720, 0x000a8721, 0x00087210,
+	0x000a8730, 0x000a8730, 0x000a8731, 0x00087310, 0x000a8732, 0x00087320,
+	0x00087321, 0x00073210, 0x000a8740, 0x000a8740, 0x000a8741, 0x00087410,
+	0x000a8742, 0x00087420, 0x00087421, 0x00074210, 0x000a8743, 0x00087430,
+	0x00087431, 0x00074310, 0x00087432, 0x00074320, 0x00074321, 0x00043210,
+	0x000a8750, 0x000a8750, 0x000a8751, 0x00087510, 0x000a8752, 0x00087520,
+	0x00087521, 0x00075210, 0x000a8753, 0x00087530, 0x00087531, 0x00075310,
+	0x00087532, 0x00075320, 0x00075321, 0x00053210, 0x000a8754, 0x00087540,
+	0x00087541, 0x00075410, 0x00087542, 0x00075420, 0x00075421, 0x00054210,
+	0x00087543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x000a8760, 0x000a8760, 0x000a8761, 0x00087610,
+	0x000a8762, 0x00087620, 0x00087621, 0x00076210, 0x000a8763, 0x00087630,
+	0x00087631, 0x00076310, 0x00087632, 0x00076320, 0x00076321, 0x00063210,
+	0x000a8764, 0x00087640, 0x00087641, 0x00076410, 0x00087642, 0x00076420,
+	0x00076421, 0x00064210, 0x00087643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x000a8765, 0x00087650,
+	0x00087651, 0x00076510, 0x00087652, 0x00076520, 0x00076521, 0x00065210,
+	0x00087653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x00087654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000a9000, 0x000a9000, 0x000a9100, 0x000a9100, 0x000a9200, 0x000a9200,
+	0x000a9210, 0x000a9210, 0x000a9300, 0x000a9300, 0x000a9310, 0x000a9310,
+	0x000a9320, 0x000a9320, 0x000a9321, 0x00093210, 0x000a9400, 0x000a9400,
+	0x000a9410, 0x000a9410, 0x000a9420, 0x000a9420, 0x000a9421, 0x00094210,
+	0x000a9430, 0x000a9430, 0x000a9431, 0x00094310, 0x000a9432, 0x00094320,
+	0x00094321, 0x00043210, 0x000a9500, 0x000a9500, 0x000a9510, 0x000a9510,
+	0x000a9520, 0x000a9520, 0x000a9521, 0x00095210, 0x000a9530, 0x000a9530,
+	0x000a9531, 0x00095310, 0x000a9532, 0x00095320, 0x00095321, 0x00053210,
+	0x000a9540, 0x000a9540, 0x000a9541, 0x00095410, 0x000a9542, 0x00095420,
+	0x00095421, 0x00054210, 0x000a9543, 0x00095430, 0x00095431, 0x00054310,
+	0x00095432, 0x00054320, 0x00054321, 0x00043210, 0x000a9600, 0x000a9600,
+	0x000a9610, 0x000a9610, 0x000a9620, 0x000a9620, 0x000a9621, 0x00096210,
+	0x000a9630, 0x000a9630, 0x000a9631, 0x00096310, 0x000a9632, 0x00096320,
+	0x00096321, 0x00063210, 0x000a9640, 0x000a9640, 0x000a9641, 0x00096410,
+	0x000a9642, 0x00096420, 0x00096421, 0x00064210, 0x000a9643, 0x00096430,
+	0x00096431, 0x00064310, 0x00096432, 0x00064320, 0x00064321, 0x00043210,
+	0x000a9650, 0x000a9650, 0x000a9651, 0x00096510, 0x000a9652, 0x00096520,
+	0x00096521, 0x00065210, 0x000a9653, 0x00096530, 0x00096531, 0x00065310,
+	0x00096532, 0x00065320, 0x00065321, 0x00053210, 0x000a9654, 0x00096540,
+	0x00096541, 0x00065410, 0x00096542, 0x00065420, 0x00065421, 0x00054210,
+	0x00096543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000a9700, 0x000a9700, 0x000a9710, 0x000a9710,
+	0x000a9720, 0x000a9720, 0x000a9721, 0x00097210, 0x000a9730, 0x000a9730,
+	0x000a9731, 0x00097310, 0x000a9732, 0x00097320, 0x00097321, 0x00073210,
+	0x000a9740, 0x000a9740, 0x000a9741, 0x00097410, 0x000a9742, 0x00097420,
+	0x00097421, 0x00074210, 0x000a9743, 0x00097430, 0x00097431, 0x00074310,
+	0x00097432, 0x00074320, 0x00074321, 0x00043210, 0x000a9750, 0x000a9750,
+	0x000a9751, 0x00097510, 0x000a9752, 0x00097520, 0x00097521, 0x00075210,
+	0x000a9753, 0x00097530, 0x00097531, 0x00075310, 0x00097532, 0x00075320,
+	0x00075321, 0x00053210, 0x000a9754, 0x00097540, 0x00097541, 0x00075410,
+	0x00097542, 0x00075420, 0x00075421, 0x00054210, 0x00097543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x000a9760, 0x000a9760, 0x000a9761, 0x00097610, 0x000a9762, 0x00097620,
+	0x00097621, 0x00076210, 0x000a9763, 0x00097630, 0x00097631, 0x00076310,
+	0x00097632, 0x00076320, 0x00076321, 0x00063210, 0x000a9764, 0x00097640,
+	0x00097641, 0x00076410, 0x00097642, 0x00076420, 0x00076421, 0x00064210,
+	0x00097643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x000a9765, 0x00097650, 0x00097651, 0x00076510,
+	0x00097652, 0x00076520, 0x00076521, 0x00065210, 0x00097653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x00097654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000a9800, 0x000a9800,
+	0x000a9810, 0x000a9810, 0x000a9820, 0x000a9820, 0x000a9821, 0x00098210,
+	0x000a9830, 0x000a9830, 0x000a9831, 0x00098310, 0x000a9832, 0x00098320,
+	0x00098321, 0x00083210, 0x000a9840, 0x000a9840, 0x000a9841, 0x00098410,
+	0x000a9842, 0x00098420, 0x00098421, 0x00084210, 0x000a9843, 0x00098430,
+	0x00098431, 0x00084310, 0x00098432, 0x00084320, 0x00084321, 0x00043210,
+	0x000a9850, 0x000a9850, 0x000a9851, 0x00098510, 0x000a9852, 0x00098520,
+	0x00098521, 0x00085210, 0x000a9853, 0x00098530, 0x00098531, 0x00085310,
+	0x00098532, 0x00085320, 0x00085321, 0x00053210, 0x000a9854, 0x00098540,
+	0x00098541, 0x00085410, 0x00098542, 0x00085420, 0x00085421, 0x00054210,
+	0x00098543, 0x00085430, 0x00085431, 0x00054310, 0x00085432, 0x00054320,
+	0x00054321, 0x00043210, 0x000a9860, 0x000a9860, 0x000a9861, 0x00098610,
+	0x000a9862, 0x00098620, 0x00098621, 0x00086210, 0x000a9863, 0x00098630,
+	0x00098631, 0x00086310, 0x00098632, 0x00086320, 0x00086321, 0x00063210,
+	0x000a9864, 0x00098640, 0x00098641, 0x00086410, 0x00098642, 0x00086420,
+	0x00086421, 0x00064210, 0x00098643, 0x00086430, 0x00086431, 0x00064310,
+	0x00086432, 0x00064320, 0x00064321, 0x00043210, 0x000a9865, 0x00098650,
+	0x00098651, 0x00086510, 0x00098652, 0x00086520, 0x00086521, 0x00065210,
+	0x00098653, 0x00086530, 0x00086531, 0x00065310, 0x00086532, 0x00065320,
+	0x00065321, 0x00053210, 0x00098654, 0x00086540, 0x00086541, 0x00065410,
+	0x00086542, 0x00065420, 0x00065421, 0x00054210, 0x00086543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000a9870, 0x000a9870, 0x000a9871, 0x00098710, 0x000a9872, 0x00098720,
+	0x00098721, 0x00087210, 0x000a9873, 0x00098730, 0x00098731, 0x00087310,
+	0x00098732, 0x00087320, 0x00087321, 0x00073210, 0x000a9874, 0x00098740,
+	0x00098741, 0x00087410, 0x00098742, 0x00087420, 0x00087421, 0x00074210,
+	0x00098743, 0x00087430, 0x00087431, 0x00074310, 0x00087432, 0x00074320,
+	0x00074321, 0x00043210, 0x000a9875, 0x00098750, 0x00098751, 0x00087510,
+	0x00098752, 0x00087520, 0x00087521, 0x00075210, 0x00098753, 0x00087530,
+	0x00087531, 0x00075310, 0x00087532, 0x00075320, 0x00075321, 0x00053210,
+	0x00098754, 0x00087540, 0x00087541, 0x00075410, 0x00087542, 0x00075420,
+	0x00075421, 0x00054210, 0x00087543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000a9876, 0x00098760,
+	0x00098761, 0x00087610, 0x00098762, 0x00087620, 0x00087621, 0x00076210,
+	0x00098763, 0x00087630, 0x00087631, 0x00076310, 0x00087632, 0x00076320,
+	0x00076321, 0x00063210, 0x00098764, 0x00087640, 0x00087641, 0x00076410,
+	0x00087642, 0x00076420, 0x00076421, 0x00064210, 0x00087643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x00098765, 0x00087650, 0x00087651, 0x00076510, 0x00087652, 0x00076520,
+	0x00076521, 0x00065210, 0x00087653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x00087654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000b0000, 0x000b0000, 0x000b1000, 0x000b1000,
+	0x000b2000, 0x000b2000, 0x000b2100, 0x000b2100, 0x000b3000, 0x000b3000,
+	0x000b3100, 0x000b3100, 0x000b3200, 0x000b3200, 0x000b3210, 0x000b3210,
+	0x000b4000, 0x000b4000, 0x000b4100, 0x000b4100, 0x000b4200, 0x000b4200,
+	0x000b4210, 0x000b4210, 0x000b4300, 0x000b4300, 0x000b4310, 0x000b4310,
+	0x000b4320, 0x000b4320, 0x000b4321, 0x00043210, 0x000b5000, 0x000b5000,
+	0x000b5100, 0x000b5100, 0x000b5200, 0x000b5200, 0x000b5210, 0x000b5210,
+	0x000b5300, 0x000b5300, 0x000b5310, 0x000b5310, 0x000b5320, 0x000b5320,
+	0x000b5321, 0x00053210, 0x000b5400, 0x000b5400, 0x000b5410, 0x000b5410,
+	0x000b5420, 0x000b5420, 0x000b5421, 0x00054210, 0x000b5430, 0x000b5430,
+	0x000b5431, 0x00054310, 0x000b5432, 0x00054320, 0x00054321, 0x00043210,
+	0x000b6000, 0x000b6000, 0x000b6100, 0x000b6100, 0x000b6200, 0x000b6200,
+	0x000b6210, 0x000b6210, 0x000b6300, 0x000b6300, 0x000b6310, 0x000b6310,
+	0x000b6320, 0x000b6320, 0x000b6321, 0x00063210, 0x000b6400, 0x000b6400,
+	0x000b6410, 0x000b6410, 0x000b6420, 0x000b6420, 0x000b6421, 0x00064210,
+	0x000b6430, 0x000b6430, 0x000b6431, 0x00064310, 0x000b6432, 0x00064320,
+	0x00064321, 0x00043210, 0x000b6500, 0x000b6500, 0x000b6510, 0x000b6510,
+	0x000b6520, 0x000b6520, 0x000b6521, 0x00065210, 0x000b6530, 0x000b6530,
+	0x000b6531, 0x00065310, 0x000b6532, 0x00065320, 0x00065321, 0x00053210,
+	0x000b6540, 0x000b6540, 0x000b6541, 0x00065410, 0x000b6542, 0x00065420,
+	0x00065421, 0x00054210, 0x000b6543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000b7000, 0x000b7000,
+	0x000b7100, 0x000b7100, 0x000b7200, 0x000b7200, 0x000b7210, 0x000b7210,
+	0x000b7300, 0x000b7300, 0x000b7310, 0x000b7310, 0x000b7320, 0x000b7320,
+	0x000b7321, 0x00073210, 0x000b7400, 0x000b7400, 0x000b7410, 0x000b7410,
+	0x000b7420, 0x000b7420, 0x000b7421, 0x00074210, 0x000b7430, 0x000b7430,
+	0x000b7431, 0x00074310, 0x000b7432, 0x00074320, 0x00074321, 0x00043210,
+	0x000b7500, 0x000b7500, 0x000b7510, 0x000b7510, 0x000b7520, 0x000b7520,
+	0x000b7521, 0x00075210, 0x000b7530, 0x000b7530, 0x000b7531, 0x00075310,
+	0x000b7532, 0x00075320, 0x00075321, 0x00053210, 0x000b7540, 0x000b7540,
+	0x000b7541, 0x00075410, 0x000b7542, 0x00075420, 0x00075421, 0x00054210,
+	0x000b7543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x000b7600, 0x000b7600, 0x000b7610, 0x000b7610,
+	0x000b7620, 0x000b7620, 0x000b7621, 0x00076210, 0x000b7630, 0x000b7630,
+	0x000b7631, 0x00076310, 0x000b7632, 0x00076320, 0x00076321, 0x00063210,
+	0x000b7640, 0x000b7640, 0x000b7641, 0x00076410, 0x000b7642, 0x00076420,
+	0x00076421, 0x00064210, 0x000b7643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x000b7650, 0x000b7650,
+	0x000b7651, 0x00076510, 0x000b7652, 0x00076520, 0x00076521, 0x00065210,
+	0x000b7653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x000b7654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000b8000, 0x000b8000, 0x000b8100, 0x000b8100, 0x000b8200, 0x000b8200,
+	0x000b8210, 0x000b8210, 0x000b8300, 0x000b8300, 0x000b8310, 0x000b8310,
+	0x000b8320, 0x000b8320, 0x000b8321, 0x00083210, 0x000b8400, 0x000b8400,
+	0x000b8410, 0x000b8410, 0x000b8420, 0x000b8420, 0x000b8421, 0x00084210,
+	0x000b8430, 0x000b8430, 0x000b8431, 0x00084310, 0x000b8432, 0x00084320,
+	0x00084321, 0x00043210, 0x000b8500, 0x000b8500, 0x000b8510, 0x000b8510,
+	0x000b8520, 0x000b8520, 0x000b8521, 0x00085210, 0x000b8530, 0x000b8530,
+	0x000b8531, 0x00085310, 0x000b8532, 0x00085320, 0x00085321, 0x00053210,
+	0x000b8540, 0x000b8540, 0x000b8541, 0x00085410, 0x000b8542, 0x00085420,
+	0x00085421, 0x00054210, 0x000b8543, 0x00085430, 0x00085431, 0x00054310,
+	0x00085432, 0x00054320, 0x00054321, 0x00043210, 0x000b8600, 0x000b8600,
+	0x000b8610, 0x000b8610, 0x000b8620, 0x000b8620, 0x000b8621, 0x00086210,
+	0x000b8630, 0x000b8630, 0x000b8631, 0x00086310, 0x000b8632, 0x00086320,
+	0x00086321, 0x00063210, 0x000b8640, 0x000b8640, 0x000b8641, 0x00086410,
+	0x000b8642, 0x00086420, 0x00086421, 0x00064210, 0x000b8643, 0x00086430,
+	0x00086431, 0x00064310, 0x00086432, 0x00064320, 0x00064321, 0x00043210,
+	0x000b8650, 0x000b8650, 0x000b8651, 0x00086510, 0x000b8652, 0x00086520,
+	0x00086521, 0x00065210, 0x000b8653, 0x00086530, 0x00086531, 0x00065310,
+	0x00086532, 0x00065320, 0x00065321, 0x00053210, 0x000b8654, 0x00086540,
+	0x00086541, 0x00065410, 0x00086542, 0x00065420, 0x00065421, 0x00054210,
+	0x00086543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000b8700, 0x000b8700, 0x000b8710, 0x000b8710,
+	0x000b8720, 0x000b8720, 0x000b8721, 0x00087210, 0x000b8730, 0x000b8730,
+	0x000b8731, 0x00087310, 0x000b8732, 0x00087320, 0x00087321, 0x00073210,
+	0x000b8740, 0x000b8740, 0x000b8741, 0x00087410, 0x000b8742, 0x00087420,
+	0x00087421, 0x00074210, 0x000b8743, 0x00087430, 0x00087431, 0x00074310,
+	0x00087432, 0x00074320, 0x00074321, 0x00043210, 0x000b8750, 0x000b8750,
+	0x000b8751, 0x00087510, 0x000b8752, 0x00087520, 0x00087521, 0x00075210,
+	0x000b8753, 0x00087530, 0x00087531, 0x00075310, 0x00087532, 0x00075320,
+	0x00075321, 0x00053210, 0x000b8754, 0x00087540, 0x00087541, 0x00075410,
+	0x00087542, 0x00075420, 0x00075421, 0x00054210, 0x00087543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x000b8760, 0x000b8760, 0x000b8761, 0x00087610, 0x000b8762, 0x00087620,
+	0x00087621, 0x00076210, 0x000b8763, 0x00087630, 0x00087631, 0x00076310,
+	0x00087632, 0x00076320, 0x00076321, 0x00063210, 0x000b8764, 0x00087640,
+	0x00087641, 0x00076410, 0x00087642, 0x00076420, 0x00076421, 0x00064210,
+	0x00087643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x000b8765, 0x00087650, 0x00087651, 0x00076510,
+	0x00087652, 0x00076520, 0x00076521, 0x00065210, 0x00087653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x00087654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000b9000, 0x000b9000,
+	0x000b9100, 0x000b9100, 0x000b9200, 0x000b9200, 0x000b9210, 0x000b9210,
+	0x000b9300, 0x000b9300, 0x000b9310, 0x000b9310, 0x000b9320, 0x000b9320,
+	0x000b9321, 0x00093210, 0x000b9400, 0x000b9400, 0x000b9410, 0x000b9410,
+	0x000b9420, 0x000b9420, 0x000b9421, 0x00094210, 0x000b9430, 0x000b9430,
+	0x000b9431, 0x00094310, 0x000b9432, 0x00094320, 0x00094321, 0x00043210,
+	0x000b9500, 0x000b9500, 0x000b9510, 0x000b9510, 0x000b9520, 0x000b9520,
+	0x000b9521, 0x00095210, 0x000b9530, 0x000b9530, 0x000b9531, 0x00095310,
+	0x000b9532, 0x00095320, 0x00095321, 0x00053210, 0x000b9540, 0x000b9540,
+	0x000b9541, 0x00095410, 0x000b9542, 0x00095420, 0x00095421, 0x00054210,
+	0x000b9543, 0x00095430, 0x00095431, 0x00054310, 0x00095432, 0x00054320,
+	0x00054321, 0x00043210, 0x000b9600, 0x000b9600, 0x000b9610, 0x000b9610,
+	0x000b9620, 0x000b9620, 0x000b9621, 0x00096210, 0x000b9630, 0x000b9630,
+	0x000b9631, 0x00096310, 0x000b9632, 0x00096320, 0x00096321, 0x00063210,
+	0x000b9640, 0x000b9640, 0x000b9641, 0x00096410, 0x000b9642, 0x00096420,
+	0x00096421, 0x00064210, 0x000b9643, 0x00096430, 0x00096431, 0x00064310,
+	0x00096432, 0x00064320, 0x00064321, 0x00043210, 0x000b9650, 0x000b9650,
+	0x000b9651, 0x00096510, 0x000b9652, 0x00096520, 0x00096521, 0x00065210,
+	0x000b9653, 0x00096530, 0x00096531, 0x00065310, 0x00096532, 0x00065320,
+	0x00065321, 0x00053210, 0x000b9654, 0x00096540, 0x00096541, 0x00065410,
+	0x00096542, 0x00065420, 0x00065421, 0x00054210, 0x00096543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000b9700, 0x000b9700, 0x000b9710, 0x000b9710, 0x000b9720, 0x000b9720,
+	0x000b9721, 0x00097210, 0x000b9730, 0x000b9730, 0x000b9731, 0x00097310,
+	0x000b9732, 0x00097320, 0x00097321, 0x00073210, 0x000b9740, 0x000b9740,
+	0x000b9741, 0x00097410, 0x000b9742, 0x00097420, 0x00097421, 0x00074210,
+	0x000b9743, 0x00097430, 0x00097431, 0x00074310, 0x00097432, 0x00074320,
+	0x00074321, 0x00043210, 0x000b9750, 0x000b9750, 0x000b9751, 0x00097510,
+	0x000b9752, 0x00097520, 0x00097521, 0x00075210, 0x000b9753, 0x00097530,
+	0x00097531, 0x00075310, 0x00097532, 0x00075320, 0x00075321, 0x00053210,
+	0x000b9754, 0x00097540, 0x00097541, 0x00075410, 0x00097542, 0x00075420,
+	0x00075421, 0x00054210, 0x00097543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000b9760, 0x000b9760,
+	0x000b9761, 0x00097610, 0x000b9762, 0x00097620, 0x00097621, 0x00076210,
+	0x000b9763, 0x00097630, 0x00097631, 0x00076310, 0x00097632, 0x00076320,
+	0x00076321, 0x00063210, 0x000b9764, 0x00097640, 0x00097641, 0x00076410,
+	0x00097642, 0x00076420, 0x00076421, 0x00064210, 0x00097643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x000b9765, 0x00097650, 0x00097651, 0x00076510, 0x00097652, 0x00076520,
+	0x00076521, 0x00065210, 0x00097653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x00097654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000b9800, 0x000b9800, 0x000b9810, 0x000b9810,
+	0x000b9820, 0x000b9820, 0x000b9821, 0x00098210, 0x000b9830, 0x000b9830,
+	0x000b9831, 0x00098310, 0x000b9832, 0x00098320, 0x00098321, 0x00083210,
+	0x000b9840, 0x000b9840, 0x000b9841, 0x00098410, 0x000b9842, 0x00098420,
+	0x00098421, 0x00084210, 0x000b9843, 0x00098430, 0x00098431, 0x00084310,
+	0x00098432, 0x00084320, 0x00084321, 0x00043210, 0x000b9850, 0x000b9850,
+	0x000b9851, 0x00098510, 0x000b9852, 0x00098520, 0x00098521, 0x00085210,
+	0x000b9853, 0x00098530, 0x00098531, 0x00085310, 0x00098532, 0x00085320,
+	0x00085321, 0x00053210, 0x000b9854, 0x00098540, 0x00098541, 0x00085410,
+	0x00098542, 0x00085420, 0x00085421, 0x00054210, 0x00098543, 0x00085430,
+	0x00085431, 0x00054310, 0x00085432, 0x00054320, 0x00054321, 0x00043210,
+	0x000b9860, 0x000b9860, 0x000b9861, 0x00098610, 0x000b9862, 0x00098620,
+	0x00098621, 0x00086210, 0x000b9863, 0x00098630, 0x00098631, 0x00086310,
+	0x00098632, 0x00086320, 0x00086321, 0x00063210, 0x000b9864, 0x00098640,
+	0x00098641, 0x00086410, 0x00098642, 0x00086420, 0x00086421, 0x00064210,
+	0x00098643, 0x00086430, 0x00086431, 0x00064310, 0x00086432, 0x00064320,
+	0x00064321, 0x00043210, 0x000b9865, 0x00098650, 0x00098651, 0x00086510,
+	0x00098652, 0x00086520, 0x00086521, 0x00065210, 0x00098653, 0x00086530,
+	0x00086531, 0x00065310, 0x00086532, 0x00065320, 0x00065321, 0x00053210,
+	0x00098654, 0x00086540, 0x00086541, 0x00065410, 0x00086542, 0x00065420,
+	0x00065421, 0x00054210, 0x00086543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000b9870, 0x000b9870,
+	0x000b9871, 0x00098710, 0x000b9872, 0x00098720, 0x00098721, 0x00087210,
+	0x000b9873, 0x00098730, 0x00098731, 0x00087310, 0x00098732, 0x00087320,
+	0x00087321, 0x00073210, 0x000b9874, 0x00098740, 0x00098741, 0x00087410,
+	0x00098742, 0x00087420, 0x00087421, 0x00074210, 0x00098743, 0x00087430,
+	0x00087431, 0x00074310, 0x00087432, 0x00074320, 0x00074321, 0x00043210,
+	0x000b9875, 0x00098750, 0x00098751, 0x00087510, 0x00098752, 0x00087520,
+	0x00087521, 0x00075210, 0x00098753, 0x00087530, 0x00087531, 0x00075310,
+	0x00087532, 0x00075320, 0x00075321, 0x00053210, 0x00098754, 0x00087540,
+	0x00087541, 0x00075410, 0x00087542, 0x00075420, 0x00075421, 0x00054210,
+	0x00087543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x000b9876, 0x00098760, 0x00098761, 0x00087610,
+	0x00098762, 0x00087620, 0x00087621, 0x00076210, 0x00098763, 0x00087630,
+	0x00087631, 0x00076310, 0x00087632, 0x00076320, 0x00076321, 0x00063210,
+	0x00098764, 0x00087640, 0x00087641, 0x00076410, 0x00087642, 0x00076420,
+	0x00076421, 0x00064210, 0x00087643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x00098765, 0x00087650,
+	0x00087651, 0x00076510, 0x00087652, 0x00076520, 0x00076521, 0x00065210,
+	0x00087653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x00087654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ba000, 0x000ba000, 0x000ba100, 0x000ba100, 0x000ba200, 0x000ba200,
+	0x000ba210, 0x000ba210, 0x000ba300, 0x000ba300, 0x000ba310, 0x000ba310,
+	0x000ba320, 0x000ba320, 0x000ba321, 0x000a3210, 0x000ba400, 0x000ba400,
+	0x000ba410, 0x000ba410, 0x000ba420, 0x000ba420, 0x000ba421, 0x000a4210,
+	0x000ba430, 0x000ba430, 0x000ba431, 0x000a4310, 0x000ba432, 0x000a4320,
+	0x000a4321, 0x00043210, 0x000ba500, 0x000ba500, 0x000ba510, 0x000ba510,
+	0x000ba520, 0x000ba520, 0x000ba521, 0x000a5210, 0x000ba530, 0x000ba530,
+	0x000ba531, 0x000a5310, 0x000ba532, 0x000a5320, 0x000a5321, 0x00053210,
+	0x000ba540, 0x000ba540, 0x000ba541, 0x000a5410, 0x000ba542, 0x000a5420,
+	0x000a5421, 0x00054210, 0x000ba543, 0x000a5430, 0x000a5431, 0x00054310,
+	0x000a5432, 0x00054320, 0x00054321, 0x00043210, 0x000ba600, 0x000ba600,
+	0x000ba610, 0x000ba610, 0x000ba620, 0x000ba620, 0x000ba621, 0x000a6210,
+	0x000ba630, 0x000ba630, 0x000ba631, 0x000a6310, 0x000ba632, 0x000a6320,
+	0x000a6321, 0x00063210, 0x000ba640, 0x000ba640, 0x000ba641, 0x000a6410,
+	0x000ba642, 0x000a6420, 0x000a6421, 0x00064210, 0x000ba643, 0x000a6430,
+	0x000a6431, 0x00064310, 0x000a6432, 0x00064320, 0x00064321, 0x00043210,
+	0x000ba650, 0x000ba650, 0x000ba651, 0x000a6510, 0x000ba652, 0x000a6520,
+	0x000a6521, 0x00065210, 0x000ba653, 0x000a6530, 0x000a6531, 0x00065310,
+	0x000a6532, 0x00065320, 0x00065321, 0x00053210, 0x000ba654, 0x000a6540,
+	0x000a6541, 0x00065410, 0x000a6542, 0x00065420, 0x00065421, 0x00054210,
+	0x000a6543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ba700, 0x000ba700, 0x000ba710, 0x000ba710,
+	0x000ba720, 0x000ba720, 0x000ba721, 0x000a7210, 0x000ba730, 0x000ba730,
+	0x000ba731, 0x000a7310, 0x000ba732, 0x000a7320, 0x000a7321, 0x00073210,
+	0x000ba740, 0x000ba740, 0x000ba741, 0x000a7410, 0x000ba742, 0x000a7420,
+	0x000a7421, 0x00074210, 0x000ba743, 0x000a7430, 0x000a7431, 0x00074310,
+	0x000a7432, 0x00074320, 0x00074321, 0x00043210, 0x000ba750, 0x000ba750,
+	0x000ba751, 0x000a7510, 0x000ba752, 0x000a7520, 0x000a7521, 0x00075210,
+	0x000ba753, 0x000a7530, 0x000a7531, 0x00075310, 0x000a7532, 0x00075320,
+	0x00075321, 0x00053210, 0x000ba754, 0x000a7540, 0x000a7541, 0x00075410,
+	0x000a7542, 0x00075420, 0x00075421, 0x00054210, 0x000a7543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ba760, 0x000ba760, 0x000ba761, 0x000a7610, 0x000ba762, 0x000a7620,
+	0x000a7621, 0x00076210, 0x000ba763, 0x000a7630, 0x000a7631, 0x00076310,
+	0x000a7632, 0x00076320, 0x00076321, 0x00063210, 0x000ba764, 0x000a7640,
+	0x000a7641, 0x00076410, 0x000a7642, 0x00076420, 0x00076421, 0x00064210,
+	0x000a7643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x000ba765, 0x000a7650, 0x000a7651, 0x00076510,
+	0x000a7652, 0x00076520, 0x00076521, 0x00065210, 0x000a7653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x000a7654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000ba800, 0x000ba800,
+	0x000ba810, 0x000ba810, 0x000ba820, 0x000ba820, 0x000ba821, 0x000a8210,
+	0x000ba830, 0x000ba830, 0x000ba831, 0x000a8310, 0x000ba832, 0x000a8320,
+	0x000a8321, 0x00083210, 0x000ba840, 0x000ba840, 0x000ba841, 0x000a8410,
+	0x000ba842, 0x000a8420, 0x000a8421, 0x00084210, 0x000ba843, 0x000a8430,
+	0x000a8431, 0x00084310, 0x000a8432, 0x00084320, 0x00084321, 0x00043210,
+	0x000ba850, 0x000ba850, 0x000ba851, 0x000a8510, 0x000ba852, 0x000a8520,
+	0x000a8521, 0x00085210, 0x000ba853, 0x000a8530, 0x000a8531, 0x00085310,
+	0x000a8532, 0x00085320, 0x00085321, 0x00053210, 0x000ba854, 0x000a8540,
+	0x000a8541, 0x00085410, 0x000a8542, 0x00085420, 0x00085421, 0x00054210,
+	0x000a8543, 0x00085430, 0x00085431, 0x00054310, 0x00085432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ba860, 0x000ba860, 0x000ba861, 0x000a8610,
+	0x000ba862, 0x000a8620, 0x000a8621, 0x00086210, 0x000ba863, 0x000a8630,
+	0x000a8631, 0x00086310, 0x000a8632, 0x00086320, 0x00086321, 0x00063210,
+	0x000ba864, 0x000a8640, 0x000a8641, 0x00086410, 0x000a8642, 0x00086420,
+	0x00086421, 0x00064210, 0x000a8643, 0x00086430, 0x00086431, 0x00064310,
+	0x00086432, 0x00064320, 0x00064321, 0x00043210, 0x000ba865, 0x000a8650,
+	0x000a8651, 0x00086510, 0x000a8652, 0x00086520, 0x00086521, 0x00065210,
+	0x000a8653, 0x00086530, 0x00086531, 0x00065310, 0x00086532, 0x00065320,
+	0x00065321, 0x00053210, 0x000a8654, 0x00086540, 0x00086541, 0x00065410,
+	0x00086542, 0x00065420, 0x00065421, 0x00054210, 0x00086543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ba870, 0x000ba870, 0x000ba871, 0x000a8710, 0x000ba872, 0x000a8720,
+	0x000a8721, 0x00087210, 0x000ba873, 0x000a8730, 0x000a8731, 0x00087310,
+	0x000a8732, 0x00087320, 0x00087321, 0x00073210, 0x000ba874, 0x000a8740,
+	0x000a8741, 0x00087410, 0x000a8742, 0x00087420, 0x00087421, 0x00074210,
+	0x000a8743, 0x00087430, 0x00087431, 0x00074310, 0x00087432, 0x00074320,
+	0x00074321, 0x00043210, 0x000ba875, 0x000a8750, 0x000a8751, 0x00087510,
+	0x000a8752, 0x00087520, 0x00087521, 0x00075210, 0x000a8753, 0x00087530,
+	0x00087531, 0x00075310, 0x00087532, 0x00075320, 0x00075321, 0x00053210,
+	0x000a8754, 0x00087540, 0x00087541, 0x00075410, 0x00087542, 0x00075420,
+	0x00075421, 0x00054210, 0x00087543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000ba876, 0x000a8760,
+	0x000a8761, 0x00087610, 0x000a8762, 0x00087620, 0x00087621, 0x00076210,
+	0x000a8763, 0x00087630, 0x00087631, 0x00076310, 0x00087632, 0x00076320,
+	0x00076321, 0x00063210, 0x000a8764, 0x00087640, 0x00087641, 0x00076410,
+	0x00087642, 0x00076420, 0x00076421, 0x00064210, 0x00087643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x000a8765, 0x00087650, 0x00087651, 0x00076510, 0x00087652, 0x00076520,
+	0x00076521, 0x00065210, 0x00087653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x00087654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ba900, 0x000ba900, 0x000ba910, 0x000ba910,
+	0x000ba920, 0x000ba920, 0x000ba921, 0x000a9210, 0x000ba930, 0x000ba930,
+	0x000ba931, 0x000a9310, 0x000ba932, 0x000a9320, 0x000a9321, 0x00093210,
+	0x000ba940, 0x000ba940, 0x000ba941, 0x000a9410, 0x000ba942, 0x000a9420,
+	0x000a9421, 0x00094210, 0x000ba943, 0x000a9430, 0x000a9431, 0x00094310,
+	0x000a9432, 0x00094320, 0x00094321, 0x00043210, 0x000ba950, 0x000ba950,
+	0x000ba951, 0x000a9510, 0x000ba952, 0x000a9520, 0x000a9521, 0x00095210,
+	0x000ba953, 0x000a9530, 0x000a9531, 0x00095310, 0x000a9532, 0x00095320,
+	0x00095321, 0x00053210, 0x000ba954, 0x000a9540, 0x000a9541, 0x00095410,
+	0x000a9542, 0x00095420, 0x00095421, 0x00054210, 0x000a9543, 0x00095430,
+	0x00095431, 0x00054310, 0x00095432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ba960, 0x000ba960, 0x000ba961, 0x000a9610, 0x000ba962, 0x000a9620,
+	0x000a9621, 0x00096210, 0x000ba963, 0x000a9630, 0x000a9631, 0x00096310,
+	0x000a9632, 0x00096320, 0x00096321, 0x00063210, 0x000ba964, 0x000a9640,
+	0x000a9641, 0x00096410, 0x000a9642, 0x00096420, 0x00096421, 0x00064210,
+	0x000a9643, 0x00096430, 0x00096431, 0x00064310, 0x00096432, 0x00064320,
+	0x00064321, 0x00043210, 0x000ba965, 0x000a9650, 0x000a9651, 0x00096510,
+	0x000a9652, 0x00096520, 0x00096521, 0x00065210, 0x000a9653, 0x00096530,
+	0x00096531, 0x00065310, 0x00096532, 0x00065320, 0x00065321, 0x00053210,
+	0x000a9654, 0x00096540, 0x00096541, 0x00065410, 0x00096542, 0x00065420,
+	0x00065421, 0x00054210, 0x00096543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000ba970, 0x000ba970,
+	0x000ba971, 0x000a9710, 0x000ba972, 0x000a9720, 0x000a9721, 0x00097210,
+	0x000ba973, 0x000a9730, 0x000a9731, 0x00097310, 0x000a9732, 0x00097320,
+	0x00097321, 0x00073210, 0x000ba974, 0x000a9740, 0x000a9741, 0x00097410,
+	0x000a9742, 0x00097420, 0x00097421, 0x00074210, 0x000a9743, 0x00097430,
+	0x00097431, 0x00074310, 0x00097432, 0x00074320, 0x00074321, 0x00043210,
+	0x000ba975, 0x000a9750, 0x000a9751, 0x00097510, 0x000a9752, 0x00097520,
+	0x00097521, 0x00075210, 0x000a9753, 0x00097530, 0x00097531, 0x00075310,
+	0x00097532, 0x00075320, 0x00075321, 0x00053210, 0x000a9754, 0x00097540,
+	0x00097541, 0x00075410, 0x00097542, 0x00075420, 0x00075421, 0x00054210,
+	0x00097543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ba976, 0x000a9760, 0x000a9761, 0x00097610,
+	0x000a9762, 0x00097620, 0x00097621, 0x00076210, 0x000a9763, 0x00097630,
+	0x00097631, 0x00076310, 0x00097632, 0x00076320, 0x00076321, 0x00063210,
+	0x000a9764, 0x00097640, 0x00097641, 0x00076410, 0x00097642, 0x00076420,
+	0x00076421, 0x00064210, 0x00097643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x000a9765, 0x00097650,
+	0x00097651, 0x00076510, 0x00097652, 0x00076520, 0x00076521, 0x00065210,
+	0x00097653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x00097654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ba980, 0x000ba980, 0x000ba981, 0x000a9810, 0x000ba982, 0x000a9820,
+	0x000a9821, 0x00098210, 0x000ba983, 0x000a9830, 0x000a9831, 0x00098310,
+	0x000a9832, 0x00098320, 0x00098321, 0x00083210, 0x000ba984, 0x000a9840,
+	0x000a9841, 0x00098410, 0x000a9842, 0x00098420, 0x00098421, 0x00084210,
+	0x000a9843, 0x00098430, 0x00098431, 0x00084310, 0x00098432, 0x00084320,
+	0x00084321, 0x00043210, 0x000ba985, 0x000a9850, 0x000a9851, 0x00098510,
+	0x000a9852, 0x00098520, 0x00098521, 0x00085210, 0x000a9853, 0x00098530,
+	0x00098531, 0x00085310, 0x00098532, 0x00085320, 0x00085321, 0x00053210,
+	0x000a9854, 0x00098540, 0x00098541, 0x00085410, 0x00098542, 0x00085420,
+	0x00085421, 0x00054210, 0x00098543, 0x00085430, 0x00085431, 0x00054310,
+	0x00085432, 0x00054320, 0x00054321, 0x00043210, 0x000ba986, 0x000a9860,
+	0x000a9861, 0x00098610, 0x000a9862, 0x00098620, 0x00098621, 0x00086210,
+	0x000a9863, 0x00098630, 0x00098631, 0x00086310, 0x00098632, 0x00086320,
+	0x00086321, 0x00063210, 0x000a9864, 0x00098640, 0x00098641, 0x00086410,
+	0x00098642, 0x00086420, 0x00086421, 0x00064210, 0x00098643, 0x00086430,
+	0x00086431, 0x00064310, 0x00086432, 0x00064320, 0x00064321, 0x00043210,
+	0x000a9865, 0x00098650, 0x00098651, 0x00086510, 0x00098652, 0x00086520,
+	0x00086521, 0x00065210, 0x00098653, 0x00086530, 0x00086531, 0x00065310,
+	0x00086532, 0x00065320, 0x00065321, 0x00053210, 0x00098654, 0x00086540,
+	0x00086541, 0x00065410, 0x00086542, 0x00065420, 0x00065421, 0x00054210,
+	0x00086543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ba987, 0x000a9870, 0x000a9871, 0x00098710,
+	0x000a9872, 0x00098720, 0x00098721, 0x00087210, 0x000a9873, 0x00098730,
+	0x00098731, 0x00087310, 0x00098732, 0x00087320, 0x00087321, 0x00073210,
+	0x000a9874, 0x00098740, 0x00098741, 0x00087410, 0x00098742, 0x00087420,
+	0x00087421, 0x00074210, 0x00098743, 0x00087430, 0x00087431, 0x00074310,
+	0x00087432, 0x00074320, 0x00074321, 0x00043210, 0x000a9875, 0x00098750,
+	0x00098751, 0x00087510, 0x00098752, 0x00087520, 0x00087521, 0x00075210,
+	0x00098753, 0x00087530, 0x00087531, 0x00075310, 0x00087532, 0x00075320,
+	0x00075321, 0x00053210, 0x00098754, 0x00087540, 0x00087541, 0x00075410,
+	0x00087542, 0x00075420, 0x00075421, 0x00054210, 0x00087543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x000a9876, 0x00098760, 0x00098761, 0x00087610, 0x00098762, 0x00087620,
+	0x00087621, 0x00076210, 0x00098763, 0x00087630, 0x00087631, 0x00076310,
+	0x00087632, 0x00076320, 0x00076321, 0x00063210, 0x00098764, 0x00087640,
+	0x00087641, 0x00076410, 0x00087642, 0x00076420, 0x00076421, 0x00064210,
+	0x00087643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x00098765, 0x00087650, 0x00087651, 0x00076510,
+	0x00087652, 0x00076520, 0x00076521, 0x00065210, 0x00087653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x00087654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000c0000, 0x000c0000,
+	0x000c1000, 0x000c1000, 0x000c2000, 0x000c2000, 0x000c2100, 0x000c2100,
+	0x000c3000, 0x000c3000, 0x000c3100, 0x000c3100, 0x000c3200, 0x000c3200,
+	0x000c3210, 0x000c3210, 0x000c4000, 0x000c4000, 0x000c4100, 0x000c4100,
+	0x000c4200, 0x000c4200, 0x000c4210, 0x000c4210, 0x000c4300, 0x000c4300,
+	0x000c4310, 0x000c4310, 0x000c4320, 0x000c4320, 0x000c4321, 0x00043210,
+	0x000c5000, 0x000c5000, 0x000c5100, 0x000c5100, 0x000c5200, 0x000c5200,
+	0x000c5210, 0x000c5210, 0x000c5300, 0x000c5300, 0x000c5310, 0x000c5310,
+	0x000c5320, 0x000c5320, 0x000c5321, 0x00053210, 0x000c5400, 0x000c5400,
+	0x000c5410, 0x000c5410, 0x000c5420, 0x000c5420, 0x000c5421, 0x00054210,
+	0x000c5430, 0x000c5430, 0x000c5431, 0x00054310, 0x000c5432, 0x00054320,
+	0x00054321, 0x00043210, 0x000c6000, 0x000c6000, 0x000c6100, 0x000c6100,
+	0x000c6200, 0x000c6200, 0x000c6210, 0x000c6210, 0x000c6300, 0x000c6300,
+	0x000c6310, 0x000c6310, 0x000c6320, 0x000c6320, 0x000c6321, 0x00063210,
+	0x000c6400, 0x000c6400, 0x000c6410, 0x000c6410, 0x000c6420, 0x000c6420,
+	0x000c6421, 0x00064210, 0x000c6430, 0x000c6430, 0x000c6431, 0x00064310,
+	0x000c6432, 0x00064320, 0x00064321, 0x00043210, 0x000c6500, 0x000c6500,
+	0x000c6510, 0x000c6510, 0x000c6520, 0x000c6520, 0x000c6521, 0x00065210,
+	0x000c6530, 0x000c6530, 0x000c6531, 0x00065310, 0x000c6532, 0x00065320,
+	0x00065321, 0x00053210, 0x000c6540, 0x000c6540, 0x000c6541, 0x00065410,
+	0x000c6542, 0x00065420, 0x00065421, 0x00054210, 0x000c6543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000c7000, 0x000c7000, 0x000c7100, 0x000c7100, 0x000c7200, 0x000c7200,
+	0x000c7210, 0x000c7210, 0x000c7300, 0x000c7300, 0x000c7310, 0x000c7310,
+	0x000c7320, 0x000c7320, 0x000c7321, 0x00073210, 0x000c7400, 0x000c7400,
+	0x000c7410, 0x000c7410, 0x000c7420, 0x000c7420, 0x000c7421, 0x00074210,
+	0x000c7430, 0x000c7430, 0x000c7431, 0x00074310, 0x000c7432, 0x00074320,
+	0x00074321, 0x00043210, 0x000c7500, 0x000c7500, 0x000c7510, 0x000c7510,
+	0x000c7520, 0x000c7520, 0x000c7521, 0x00075210, 0x000c7530, 0x000c7530,
+	0x000c7531, 0x00075310, 0x000c7532, 0x00075320, 0x00075321, 0x00053210,
+	0x000c7540, 0x000c7540, 0x000c7541, 0x00075410, 0x000c7542, 0x00075420,
+	0x00075421, 0x00054210, 0x000c7543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000c7600, 0x000c7600,
+	0x000c7610, 0x000c7610, 0x000c7620, 0x000c7620, 0x000c7621, 0x00076210,
+	0x000c7630, 0x000c7630, 0x000c7631, 0x00076310, 0x000c7632, 0x00076320,
+	0x00076321, 0x00063210, 0x000c7640, 0x000c7640, 0x000c7641, 0x00076410,
+	0x000c7642, 0x00076420, 0x00076421, 0x00064210, 0x000c7643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x000c7650, 0x000c7650, 0x000c7651, 0x00076510, 0x000c7652, 0x00076520,
+	0x00076521, 0x00065210, 0x000c7653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x000c7654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000c8000, 0x000c8000, 0x000c8100, 0x000c8100,
+	0x000c8200, 0x000c8200, 0x000c8210, 0x000c8210, 0x000c8300, 0x000c8300,
+	0x000c8310, 0x000c8310, 0x000c8320, 0x000c8320, 0x000c8321, 0x00083210,
+	0x000c8400, 0x000c8400, 0x000c8410, 0x000c8410, 0x000c8420, 0x000c8420,
+	0x000c8421, 0x00084210, 0x000c8430, 0x000c8430, 0x000c8431, 0x00084310,
+	0x000c8432, 0x00084320, 0x00084321, 0x00043210, 0x000c8500, 0x000c8500,
+	0x000c8510, 0x000c8510, 0x000c8520, 0x000c8520, 0x000c8521, 0x00085210,
+	0x000c8530, 0x000c8530, 0x000c8531, 0x00085310, 0x000c8532, 0x00085320,
+	0x00085321, 0x00053210, 0x000c8540, 0x000c8540, 0x000c8541, 0x00085410,
+	0x000c8542, 0x00085420, 0x00085421, 0x00054210, 0x000c8543, 0x00085430,
+	0x00085431, 0x00054310, 0x00085432, 0x00054320, 0x00054321, 0x00043210,
+	0x000c8600, 0x000c8600, 0x000c8610, 0x000c8610, 0x000c8620, 0x000c8620,
+	0x000c8621, 0x00086210, 0x000c8630, 0x000c8630, 0x000c8631, 0x00086310,
+	0x000c8632, 0x00086320, 0x00086321, 0x00063210, 0x000c8640, 0x000c8640,
+	0x000c8641, 0x00086410, 0x000c8642, 0x00086420, 0x00086421, 0x00064210,
+	0x000c8643, 0x00086430, 0x00086431, 0x00064310, 0x00086432, 0x00064320,
+	0x00064321, 0x00043210, 0x000c8650, 0x000c8650, 0x000c8651, 0x00086510,
+	0x000c8652, 0x00086520, 0x00086521, 0x00065210, 0x000c8653, 0x00086530,
+	0x00086531, 0x00065310, 0x00086532, 0x00065320, 0x00065321, 0x00053210,
+	0x000c8654, 0x00086540, 0x00086541, 0x00065410, 0x00086542, 0x00065420,
+	0x00065421, 0x00054210, 0x00086543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000c8700, 0x000c8700,
+	0x000c8710, 0x000c8710, 0x000c8720, 0x000c8720, 0x000c8721, 0x00087210,
+	0x000c8730, 0x000c8730, 0x000c8731, 0x00087310, 0x000c8732, 0x00087320,
+	0x00087321, 0x00073210, 0x000c8740, 0x000c8740, 0x000c8741, 0x00087410,
+	0x000c8742, 0x00087420, 0x00087421, 0x00074210, 0x000c8743, 0x00087430,
+	0x00087431, 0x00074310, 0x00087432, 0x00074320, 0x00074321, 0x00043210,
+	0x000c8750, 0x000c8750, 0x000c8751, 0x00087510, 0x000c8752, 0x00087520,
+	0x00087521, 0x00075210, 0x000c8753, 0x00087530, 0x00087531, 0x00075310,
+	0x00087532, 0x00075320, 0x00075321, 0x00053210, 0x000c8754, 0x00087540,
+	0x00087541, 0x00075410, 0x00087542, 0x00075420, 0x00075421, 0x00054210,
+	0x00087543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x000c8760, 0x000c8760, 0x000c8761, 0x00087610,
+	0x000c8762, 0x00087620, 0x00087621, 0x00076210, 0x000c8763, 0x00087630,
+	0x00087631, 0x00076310, 0x00087632, 0x00076320, 0x00076321, 0x00063210,
+	0x000c8764, 0x00087640, 0x00087641, 0x00076410, 0x00087642, 0x00076420,
+	0x00076421, 0x00064210, 0x00087643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x000c8765, 0x00087650,
+	0x00087651, 0x00076510, 0x00087652, 0x00076520, 0x00076521, 0x00065210,
+	0x00087653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x00087654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000c9000, 0x000c9000, 0x000c9100, 0x000c9100, 0x000c9200, 0x000c9200,
+	0x000c9210, 0x000c9210, 0x000c9300, 0x000c9300, 0x000c9310, 0x000c9310,
+	0x000c9320, 0x000c9320, 0x000c9321, 0x00093210, 0x000c9400, 0x000c9400,
+	0x000c9410, 0x000c9410, 0x000c9420, 0x000c9420, 0x000c9421, 0x00094210,
+	0x000c9430, 0x000c9430, 0x000c9431, 0x00094310, 0x000c9432, 0x00094320,
+	0x00094321, 0x00043210, 0x000c9500, 0x000c9500, 0x000c9510, 0x000c9510,
+	0x000c9520, 0x000c9520, 0x000c9521, 0x00095210, 0x000c9530, 0x000c9530,
+	0x000c9531, 0x00095310, 0x000c9532, 0x00095320, 0x00095321, 0x00053210,
+	0x000c9540, 0x000c9540, 0x000c9541, 0x00095410, 0x000c9542, 0x00095420,
+	0x00095421, 0x00054210, 0x000c9543, 0x00095430, 0x00095431, 0x00054310,
+	0x00095432, 0x00054320, 0x00054321, 0x00043210, 0x000c9600, 0x000c9600,
+	0x000c9610, 0x000c9610, 0x000c9620, 0x000c9620, 0x000c9621, 0x00096210,
+	0x000c9630, 0x000c9630, 0x000c9631, 0x00096310, 0x000c9632, 0x00096320,
+	0x00096321, 0x00063210, 0x000c9640, 0x000c9640, 0x000c9641, 0x00096410,
+	0x000c9642, 0x00096420, 0x00096421, 0x00064210, 0x000c9643, 0x00096430,
+	0x00096431, 0x00064310, 0x00096432, 0x00064320, 0x00064321, 0x00043210,
+	0x000c9650, 0x000c9650, 0x000c9651, 0x00096510, 0x000c9652, 0x00096520,
+	0x00096521, 0x00065210, 0x000c9653, 0x00096530, 0x00096531, 0x00065310,
+	0x00096532, 0x00065320, 0x00065321, 0x00053210, 0x000c9654, 0x00096540,
+	0x00096541, 0x00065410, 0x00096542, 0x00065420, 0x00065421, 0x00054210,
+	0x00096543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000c9700, 0x000c9700, 0x000c9710, 0x000c9710,
+	0x000c9720, 0x000c9720, 0x000c9721, 0x00097210, 0x000c9730, 0x000c9730,
+	0x000c9731, 0x00097310, 0x000c9732, 0x00097320, 0x00097321, 0x00073210,
+	0x000c9740, 0x000c9740, 0x000c9741, 0x00097410, 0x000c9742, 0x00097420,
+	0x00097421, 0x00074210, 0x000c9743, 0x00097430, 0x00097431, 0x00074310,
+	0x00097432, 0x00074320, 0x00074321, 0x00043210, 0x000c9750, 0x000c9750,
+	0x000c9751, 0x00097510, 0x000c9752, 0x00097520, 0x00097521, 0x00075210,
+	0x000c9753, 0x00097530, 0x00097531, 0x00075310, 0x00097532, 0x00075320,
+	0x00075321, 0x00053210, 0x000c9754, 0x00097540, 0x00097541, 0x00075410,
+	0x00097542, 0x00075420, 0x00075421, 0x00054210, 0x00097543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x000c9760, 0x000c9760, 0x000c9761, 0x00097610, 0x000c9762, 0x00097620,
+	0x00097621, 0x00076210, 0x000c9763, 0x00097630, 0x00097631, 0x00076310,
+	0x00097632, 0x00076320, 0x00076321, 0x00063210, 0x000c9764, 0x00097640,
+	0x00097641, 0x00076410, 0x00097642, 0x00076420, 0x00076421, 0x00064210,
+	0x00097643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x000c9765, 0x00097650, 0x00097651, 0x00076510,
+	0x00097652, 0x00076520, 0x00076521, 0x00065210, 0x00097653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x00097654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000c9800, 0x000c9800,
+	0x000c9810, 0x000c9810, 0x000c9820, 0x000c9820, 0x000c9821, 0x00098210,
+	0x000c9830, 0x000c9830, 0x000c9831, 0x00098310, 0x000c9832, 0x00098320,
+	0x00098321, 0x00083210, 0x000c9840, 0x000c9840, 0x000c9841, 0x00098410,
+	0x000c9842, 0x00098420, 0x00098421, 0x00084210, 0x000c9843, 0x00098430,
+	0x00098431, 0x00084310, 0x00098432, 0x00084320, 0x00084321, 0x00043210,
+	0x000c9850, 0x000c9850, 0x000c9851, 0x00098510, 0x000c9852, 0x00098520,
+	0x00098521, 0x00085210, 0x000c9853, 0x00098530, 0x00098531, 0x00085310,
+	0x00098532, 0x00085320, 0x00085321, 0x00053210, 0x000c9854, 0x00098540,
+	0x00098541, 0x00085410, 0x00098542, 0x00085420, 0x00085421, 0x00054210,
+	0x00098543, 0x00085430, 0x00085431, 0x00054310, 0x00085432, 0x00054320,
+	0x00054321, 0x00043210, 0x000c9860, 0x000c9860, 0x000c9861, 0x00098610,
+	0x000c9862, 0x00098620, 0x00098621, 0x00086210, 0x000c9863, 0x00098630,
+	0x00098631, 0x00086310, 0x00098632, 0x00086320, 0x00086321, 0x00063210,
+	0x000c9864, 0x00098640, 0x00098641, 0x00086410, 0x00098642, 0x00086420,
+	0x00086421, 0x00064210, 0x00098643, 0x00086430, 0x00086431, 0x00064310,
+	0x00086432, 0x00064320, 0x00064321, 0x00043210, 0x000c9865, 0x00098650,
+	0x00098651, 0x00086510, 0x00098652, 0x00086520, 0x00086521, 0x00065210,
+	0x00098653, 0x00086530, 0x00086531, 0x00065310, 0x00086532, 0x00065320,
+	0x00065321, 0x00053210, 0x00098654, 0x00086540, 0x00086541, 0x00065410,
+	0x00086542, 0x00065420, 0x00065421, 0x00054210, 0x00086543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000c9870, 0x000c9870, 0x000c9871, 0x00098710, 0x000c9872, 0x00098720,
+	0x00098721, 0x00087210, 0x000c9873, 0x00098730, 0x00098731, 0x00087310,
+	0x00098732, 0x00087320, 0x00087321, 0x00073210, 0x000c9874, 0x00098740,
+	0x00098741, 0x00087410, 0x00098742, 0x00087420, 0x00087421, 0x00074210,
+	0x00098743, 0x00087430, 0x00087431, 0x00074310, 0x00087432, 0x00074320,
+	0x00074321, 0x00043210, 0x000c9875, 0x00098750, 0x00098751, 0x00087510,
+	0x00098752, 0x00087520, 0x00087521, 0x00075210, 0x00098753, 0x00087530,
+	0x00087531, 0x00075310, 0x00087532, 0x00075320, 0x00075321, 0x00053210,
+	0x00098754, 0x00087540, 0x00087541, 0x00075410, 0x00087542, 0x00075420,
+	0x00075421, 0x00054210, 0x00087543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000c9876, 0x00098760,
+	0x00098761, 0x00087610, 0x00098762, 0x00087620, 0x00087621, 0x00076210,
+	0x00098763, 0x00087630, 0x00087631, 0x00076310, 0x00087632, 0x00076320,
+	0x00076321, 0x00063210, 0x00098764, 0x00087640, 0x00087641, 0x00076410,
+	0x00087642, 0x00076420, 0x00076421, 0x00064210, 0x00087643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x00098765, 0x00087650, 0x00087651, 0x00076510, 0x00087652, 0x00076520,
+	0x00076521, 0x00065210, 0x00087653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x00087654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ca000, 0x000ca000, 0x000ca100, 0x000ca100,
+	0x000ca200, 0x000ca200, 0x000ca210, 0x000ca210, 0x000ca300, 0x000ca300,
+	0x000ca310, 0x000ca310, 0x000ca320, 0x000ca320, 0x000ca321, 0x000a3210,
+	0x000ca400, 0x000ca400, 0x000ca410, 0x000ca410, 0x000ca420, 0x000ca420,
+	0x000ca421, 0x000a4210, 0x000ca430, 0x000ca430, 0x000ca431, 0x000a4310,
+	0x000ca432, 0x000a4320, 0x000a4321, 0x00043210, 0x000ca500, 0x000ca500,
+	0x000ca510, 0x000ca510, 0x000ca520, 0x000ca520, 0x000ca521, 0x000a5210,
+	0x000ca530, 0x000ca530, 0x000ca531, 0x000a5310, 0x000ca532, 0x000a5320,
+	0x000a5321, 0x00053210, 0x000ca540, 0x000ca540, 0x000ca541, 0x000a5410,
+	0x000ca542, 0x000a5420, 0x000a5421, 0x00054210, 0x000ca543, 0x000a5430,
+	0x000a5431, 0x00054310, 0x000a5432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ca600, 0x000ca600, 0x000ca610, 0x000ca610, 0x000ca620, 0x000ca620,
+	0x000ca621, 0x000a6210, 0x000ca630, 0x000ca630, 0x000ca631, 0x000a6310,
+	0x000ca632, 0x000a6320, 0x000a6321, 0x00063210, 0x000ca640, 0x000ca640,
+	0x000ca641, 0x000a6410, 0x000ca642, 0x000a6420, 0x000a6421, 0x00064210,
+	0x000ca643, 0x000a6430, 0x000a6431, 0x00064310, 0x000a6432, 0x00064320,
+	0x00064321, 0x00043210, 0x000ca650, 0x000ca650, 0x000ca651, 0x000a6510,
+	0x000ca652, 0x000a6520, 0x000a6521, 0x00065210, 0x000ca653, 0x000a6530,
+	0x000a6531, 0x00065310, 0x000a6532, 0x00065320, 0x00065321, 0x00053210,
+	0x000ca654, 0x000a6540, 0x000a6541, 0x00065410, 0x000a6542, 0x00065420,
+	0x00065421, 0x00054210, 0x000a6543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000ca700, 0x000ca700,
+	0x000ca710, 0x000ca710, 0x000ca720, 0x000ca720, 0x000ca721, 0x000a7210,
+	0x000ca730, 0x000ca730, 0x000ca731, 0x000a7310, 0x000ca732, 0x000a7320,
+	0x000a7321, 0x00073210, 0x000ca740, 0x000ca740, 0x000ca741, 0x000a7410,
+	0x000ca742, 0x000a7420, 0x000a7421, 0x00074210, 0x000ca743, 0x000a7430,
+	0x000a7431, 0x00074310, 0x000a7432, 0x00074320, 0x00074321, 0x00043210,
+	0x000ca750, 0x000ca750, 0x000ca751, 0x000a7510, 0x000ca752, 0x000a7520,
+	0x000a7521, 0x00075210, 0x000ca753, 0x000a7530, 0x000a7531, 0x00075310,
+	0x000a7532, 0x00075320, 0x00075321, 0x00053210, 0x000ca754, 0x000a7540,
+	0x000a7541, 0x00075410, 0x000a7542, 0x00075420, 0x00075421, 0x00054210,
+	0x000a7543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ca760, 0x000ca760, 0x000ca761, 0x000a7610,
+	0x000ca762, 0x000a7620, 0x000a7621, 0x00076210, 0x000ca763, 0x000a7630,
+	0x000a7631, 0x00076310, 0x000a7632, 0x00076320, 0x00076321, 0x00063210,
+	0x000ca764, 0x000a7640, 0x000a7641, 0x00076410, 0x000a7642, 0x00076420,
+	0x00076421, 0x00064210, 0x000a7643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x000ca765, 0x000a7650,
+	0x000a7651, 0x00076510, 0x000a7652, 0x00076520, 0x00076521, 0x00065210,
+	0x000a7653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x000a7654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ca800, 0x000ca800, 0x000ca810, 0x000ca810, 0x000ca820, 0x000ca820,
+	0x000ca821, 0x000a8210, 0x000ca830, 0x000ca830, 0x000ca831, 0x000a8310,
+	0x000ca832, 0x000a8320, 0x000a8321, 0x00083210, 0x000ca840, 0x000ca840,
+	0x000ca841, 0x000a8410, 0x000ca842, 0x000a8420, 0x000a8421, 0x00084210,
+	0x000ca843, 0x000a8430, 0x000a8431, 0x00084310, 0x000a8432, 0x00084320,
+	0x00084321, 0x00043210, 0x000ca850, 0x000ca850, 0x000ca851, 0x000a8510,
+	0x000ca852, 0x000a8520, 0x000a8521, 0x00085210, 0x000ca853, 0x000a8530,
+	0x000a8531, 0x00085310, 0x000a8532, 0x00085320, 0x00085321, 0x00053210,
+	0x000ca854, 0x000a8540, 0x000a8541, 0x00085410, 0x000a8542, 0x00085420,
+	0x00085421, 0x00054210, 0x000a8543, 0x00085430, 0x00085431, 0x00054310,
+	0x00085432, 0x00054320, 0x00054321, 0x00043210, 0x000ca860, 0x000ca860,
+	0x000ca861, 0x000a8610, 0x000ca862, 0x000a8620, 0x000a8621, 0x00086210,
+	0x000ca863, 0x000a8630, 0x000a8631, 0x00086310, 0x000a8632, 0x00086320,
+	0x00086321, 0x00063210, 0x000ca864, 0x000a8640, 0x000a8641, 0x00086410,
+	0x000a8642, 0x00086420, 0x00086421, 0x00064210, 0x000a8643, 0x00086430,
+	0x00086431, 0x00064310, 0x00086432, 0x00064320, 0x00064321, 0x00043210,
+	0x000ca865, 0x000a8650, 0x000a8651, 0x00086510, 0x000a8652, 0x00086520,
+	0x00086521, 0x00065210, 0x000a8653, 0x00086530, 0x00086531, 0x00065310,
+	0x00086532, 0x00065320, 0x00065321, 0x00053210, 0x000a8654, 0x00086540,
+	0x00086541, 0x00065410, 0x00086542, 0x00065420, 0x00065421, 0x00054210,
+	0x00086543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ca870, 0x000ca870, 0x000ca871, 0x000a8710,
+	0x000ca872, 0x000a8720, 0x000a8721, 0x00087210, 0x000ca873, 0x000a8730,
+	0x000a8731, 0x00087310, 0x000a8732, 0x00087320, 0x00087321, 0x00073210,
+	0x000ca874, 0x000a8740, 0x000a8741, 0x00087410, 0x000a8742, 0x00087420,
+	0x00087421, 0x00074210, 0x000a8743, 0x00087430, 0x00087431, 0x00074310,
+	0x00087432, 0x00074320, 0x00074321, 0x00043210, 0x000ca875, 0x000a8750,
+	0x000a8751, 0x00087510, 0x000a8752, 0x00087520, 0x00087521, 0x00075210,
+	0x000a8753, 0x00087530, 0x00087531, 0x00075310, 0x00087532, 0x00075320,
+	0x00075321, 0x00053210, 0x000a8754, 0x00087540, 0x00087541, 0x00075410,
+	0x00087542, 0x00075420, 0x00075421, 0x00054210, 0x00087543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ca876, 0x000a8760, 0x000a8761, 0x00087610, 0x000a8762, 0x00087620,
+	0x00087621, 0x00076210, 0x000a8763, 0x00087630, 0x00087631, 0x00076310,
+	0x00087632, 0x00076320, 0x00076321, 0x00063210, 0x000a8764, 0x00087640,
+	0x00087641, 0x00076410, 0x00087642, 0x00076420, 0x00076421, 0x00064210,
+	0x00087643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x000a8765, 0x00087650, 0x00087651, 0x00076510,
+	0x00087652, 0x00076520, 0x00076521, 0x00065210, 0x00087653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x00087654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000ca900, 0x000ca900,
+	0x000ca910, 0x000ca910, 0x000ca920, 0x000ca920, 0x000ca921, 0x000a9210,
+	0x000ca930, 0x000ca930, 0x000ca931, 0x000a9310, 0x000ca932, 0x000a9320,
+	0x000a9321, 0x00093210, 0x000ca940, 0x000ca940, 0x000ca941, 0x000a9410,
+	0x000ca942, 0x000a9420, 0x000a9421, 0x00094210, 0x000ca943, 0x000a9430,
+	0x000a9431, 0x00094310, 0x000a9432, 0x00094320, 0x00094321, 0x00043210,
+	0x000ca950, 0x000ca950, 0x000ca951, 0x000a9510, 0x000ca952, 0x000a9520,
+	0x000a9521, 0x00095210, 0x000ca953, 0x000a9530, 0x000a9531, 0x00095310,
+	0x000a9532, 0x00095320, 0x00095321, 0x00053210, 0x000ca954, 0x000a9540,
+	0x000a9541, 0x00095410, 0x000a9542, 0x00095420, 0x00095421, 0x00054210,
+	0x000a9543, 0x00095430, 0x00095431, 0x00054310, 0x00095432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ca960, 0x000ca960, 0x000ca961, 0x000a9610,
+	0x000ca962, 0x000a9620, 0x000a9621, 0x00096210, 0x000ca963, 0x000a9630,
+	0x000a9631, 0x00096310, 0x000a9632, 0x00096320, 0x00096321, 0x00063210,
+	0x000ca964, 0x000a9640, 0x000a9641, 0x00096410, 0x000a9642, 0x00096420,
+	0x00096421, 0x00064210, 0x000a9643, 0x00096430, 0x00096431, 0x00064310,
+	0x00096432, 0x00064320, 0x00064321, 0x00043210, 0x000ca965, 0x000a9650,
+	0x000a9651, 0x00096510, 0x000a9652, 0x00096520, 0x00096521, 0x00065210,
+	0x000a9653, 0x00096530, 0x00096531, 0x00065310, 0x00096532, 0x00065320,
+	0x00065321, 0x00053210, 0x000a9654, 0x00096540, 0x00096541, 0x00065410,
+	0x00096542, 0x00065420, 0x00065421, 0x00054210, 0x00096543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ca970, 0x000ca970, 0x000ca971, 0x000a9710, 0x000ca972, 0x000a9720,
+	0x000a9721, 0x00097210, 0x000ca973, 0x000a9730, 0x000a9731, 0x00097310,
+	0x000a9732, 0x00097320, 0x00097321, 0x00073210, 0x000ca974, 0x000a9740,
+	0x000a9741, 0x00097410, 0x000a9742, 0x00097420, 0x00097421, 0x00074210,
+	0x000a9743, 0x00097430, 0x00097431, 0x00074310, 0x00097432, 0x00074320,
+	0x00074321, 0x00043210, 0x000ca975, 0x000a9750, 0x000a9751, 0x00097510,
+	0x000a9752, 0x00097520, 0x00097521, 0x00075210, 0x000a9753, 0x00097530,
+	0x00097531, 0x00075310, 0x00097532, 0x00075320, 0x00075321, 0x00053210,
+	0x000a9754, 0x00097540, 0x00097541, 0x00075410, 0x00097542, 0x00075420,
+	0x00075421, 0x00054210, 0x00097543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000ca976, 0x000a9760,
+	0x000a9761, 0x00097610, 0x000a9762, 0x00097620, 0x00097621, 0x00076210,
+	0x000a9763, 0x00097630, 0x00097631, 0x00076310, 0x00097632, 0x00076320,
+	0x00076321, 0x00063210, 0x000a9764, 0x00097640, 0x00097641, 0x00076410,
+	0x00097642, 0x00076420, 0x00076421, 0x00064210, 0x00097643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x000a9765, 0x00097650, 0x00097651, 0x00076510, 0x00097652, 0x00076520,
+	0x00076521, 0x00065210, 0x00097653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x00097654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ca980, 0x000ca980, 0x000ca981, 0x000a9810,
+	0x000ca982, 0x000a9820, 0x000a9821, 0x00098210, 0x000ca983, 0x000a9830,
+	0x000a9831, 0x00098310, 0x000a9832, 0x00098320, 0x00098321, 0x00083210,
+	0x000ca984, 0x000a9840, 0x000a9841, 0x00098410, 0x000a9842, 0x00098420,
+	0x00098421, 0x00084210, 0x000a9843, 0x00098430, 0x00098431, 0x00084310,
+	0x00098432, 0x00084320, 0x00084321, 0x00043210, 0x000ca985, 0x000a9850,
+	0x000a9851, 0x00098510, 0x000a9852, 0x00098520, 0x00098521, 0x00085210,
+	0x000a9853, 0x00098530, 0x00098531, 0x00085310, 0x00098532, 0x00085320,
+	0x00085321, 0x00053210, 0x000a9854, 0x00098540, 0x00098541, 0x00085410,
+	0x00098542, 0x00085420, 0x00085421, 0x00054210, 0x00098543, 0x00085430,
+	0x00085431, 0x00054310, 0x00085432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ca986, 0x000a9860, 0x000a9861, 0x00098610, 0x000a9862, 0x00098620,
+	0x00098621, 0x00086210, 0x000a9863, 0x00098630, 0x00098631, 0x00086310,
+	0x00098632, 0x00086320, 0x00086321, 0x00063210, 0x000a9864, 0x00098640,
+	0x00098641, 0x00086410, 0x00098642, 0x00086420, 0x00086421, 0x00064210,
+	0x00098643, 0x00086430, 0x00086431, 0x00064310, 0x00086432, 0x00064320,
+	0x00064321, 0x00043210, 0x000a9865, 0x00098650, 0x00098651, 0x00086510,
+	0x00098652, 0x00086520, 0x00086521, 0x00065210, 0x00098653, 0x00086530,
+	0x00086531, 0x00065310, 0x00086532, 0x00065320, 0x00065321, 0x00053210,
+	0x00098654, 0x00086540, 0x00086541, 0x00065410, 0x00086542, 0x00065420,
+	0x00065421, 0x00054210, 0x00086543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000ca987, 0x000a9870,
+	0x000a9871, 0x00098710, 0x000a9872, 0x00098720, 0x00098721, 0x00087210,
+	0x000a9873, 0x00098730, 0x00098731, 0x00087310, 0x00098732, 0x00087320,
+	0x00087321, 0x00073210, 0x000a9874, 0x00098740, 0x00098741, 0x00087410,
+	0x00098742, 0x00087420, 0x00087421, 0x00074210, 0x00098743, 0x00087430,
+	0x00087431, 0x00074310, 0x00087432, 0x00074320, 0x00074321, 0x00043210,
+	0x000a9875, 0x00098750, 0x00098751, 0x00087510, 0x00098752, 0x00087520,
+	0x00087521, 0x00075210, 0x00098753, 0x00087530, 0x00087531, 0x00075310,
+	0x00087532, 0x00075320, 0x00075321, 0x00053210, 0x00098754, 0x00087540,
+	0x00087541, 0x00075410, 0x00087542, 0x00075420, 0x00075421, 0x00054210,
+	0x00087543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x000a9876, 0x00098760, 0x00098761, 0x00087610,
+	0x00098762, 0x00087620, 0x00087621, 0x00076210, 0x00098763, 0x00087630,
+	0x00087631, 0x00076310, 0x00087632, 0x00076320, 0x00076321, 0x00063210,
+	0x00098764, 0x00087640, 0x00087641, 0x00076410, 0x00087642, 0x00076420,
+	0x00076421, 0x00064210, 0x00087643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x00098765, 0x00087650,
+	0x00087651, 0x00076510, 0x00087652, 0x00076520, 0x00076521, 0x00065210,
+	0x00087653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x00087654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000cb000, 0x000cb000, 0x000cb100, 0x000cb100, 0x000cb200, 0x000cb200,
+	0x000cb210, 0x000cb210, 0x000cb300, 0x000cb300, 0x000cb310, 0x000cb310,
+	0x000cb320, 0x000cb320, 0x000cb321, 0x000b3210, 0x000cb400, 0x000cb400,
+	0x000cb410, 0x000cb410, 0x000cb420, 0x000cb420, 0x000cb421, 0x000b4210,
+	0x000cb430, 0x000cb430, 0x000cb431, 0x000b4310, 0x000cb432, 0x000b4320,
+	0x000b4321, 0x00043210, 0x000cb500, 0x000cb500, 0x000cb510, 0x000cb510,
+	0x000cb520, 0x000cb520, 0x000cb521, 0x000b5210, 0x000cb530, 0x000cb530,
+	0x000cb531, 0x000b5310, 0x000cb532, 0x000b5320, 0x000b5321, 0x00053210,
+	0x000cb540, 0x000cb540, 0x000cb541, 0x000b5410, 0x000cb542, 0x000b5420,
+	0x000b5421, 0x00054210, 0x000cb543, 0x000b5430, 0x000b5431, 0x00054310,
+	0x000b5432, 0x00054320, 0x00054321, 0x00043210, 0x000cb600, 0x000cb600,
+	0x000cb610, 0x000cb610, 0x000cb620, 0x000cb620, 0x000cb621, 0x000b6210,
+	0x000cb630, 0x000cb630, 0x000cb631, 0x000b6310, 0x000cb632, 0x000b6320,
+	0x000b6321, 0x00063210, 0x000cb640, 0x000cb640, 0x000cb641, 0x000b6410,
+	0x000cb642, 0x000b6420, 0x000b6421, 0x00064210, 0x000cb643, 0x000b6430,
+	0x000b6431, 0x00064310, 0x000b6432, 0x00064320, 0x00064321, 0x00043210,
+	0x000cb650, 0x000cb650, 0x000cb651, 0x000b6510, 0x000cb652, 0x000b6520,
+	0x000b6521, 0x00065210, 0x000cb653, 0x000b6530, 0x000b6531, 0x00065310,
+	0x000b6532, 0x00065320, 0x00065321, 0x00053210, 0x000cb654, 0x000b6540,
+	0x000b6541, 0x00065410, 0x000b6542, 0x00065420, 0x00065421, 0x00054210,
+	0x000b6543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000cb700, 0x000cb700, 0x000cb710, 0x000cb710,
+	0x000cb720, 0x000cb720, 0x000cb721, 0x000b7210, 0x000cb730, 0x000cb730,
+	0x000cb731, 0x000b7310, 0x000cb732, 0x000b7320, 0x000b7321, 0x00073210,
+	0x000cb740, 0x000cb740, 0x000cb741, 0x000b7410, 0x000cb742, 0x000b7420,
+	0x000b7421, 0x00074210, 0x000cb743, 0x000b7430, 0x000b7431, 0x00074310,
+	0x000b7432, 0x00074320, 0x00074321, 0x00043210, 0x000cb750, 0x000cb750,
+	0x000cb751, 0x000b7510, 0x000cb752, 0x000b7520, 0x000b7521, 0x00075210,
+	0x000cb753, 0x000b7530, 0x000b7531, 0x00075310, 0x000b7532, 0x00075320,
+	0x00075321, 0x00053210, 0x000cb754, 0x000b7540, 0x000b7541, 0x00075410,
+	0x000b7542, 0x00075420, 0x00075421, 0x00054210, 0x000b7543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x000cb760, 0x000cb760, 0x000cb761, 0x000b7610, 0x000cb762, 0x000b7620,
+	0x000b7621, 0x00076210, 0x000cb763, 0x000b7630, 0x000b7631, 0x00076310,
+	0x000b7632, 0x00076320, 0x00076321, 0x00063210, 0x000cb764, 0x000b7640,
+	0x000b7641, 0x00076410, 0x000b7642, 0x00076420, 0x00076421, 0x00064210,
+	0x000b7643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x000cb765, 0x000b7650, 0x000b7651, 0x00076510,
+	0x000b7652, 0x00076520, 0x00076521, 0x00065210, 0x000b7653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x000b7654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000cb800, 0x000cb800,
+	0x000cb810, 0x000cb810, 0x000cb820, 0x000cb820, 0x000cb821, 0x000b8210,
+	0x000cb830, 0x000cb830, 0x000cb831, 0x000b8310, 0x000cb832, 0x000b8320,
+	0x000b8321, 0x00083210, 0x000cb840, 0x000cb840, 0x000cb841, 0x000b8410,
+	0x000cb842, 0x000b8420, 0x000b8421, 0x00084210, 0x000cb843, 0x000b8430,
+	0x000b8431, 0x00084310, 0x000b8432, 0x00084320, 0x00084321, 0x00043210,
+	0x000cb850, 0x000cb850, 0x000cb851, 0x000b8510, 0x000cb852, 0x000b8520,
+	0x000b8521, 0x00085210, 0x000cb853, 0x000b8530, 0x000b8531, 0x00085310,
+	0x000b8532, 0x00085320, 0x00085321, 0x00053210, 0x000cb854, 0x000b8540,
+	0x000b8541, 0x00085410, 0x000b8542, 0x00085420, 0x00085421, 0x00054210,
+	0x000b8543, 0x00085430, 0x00085431, 0x00054310, 0x00085432, 0x00054320,
+	0x00054321, 0x00043210, 0x000cb860, 0x000cb860, 0x000cb861, 0x000b8610,
+	0x000cb862, 0x000b8620, 0x000b8621, 0x00086210, 0x000cb863, 0x000b8630,
+	0x000b8631, 0x00086310, 0x000b8632, 0x00086320, 0x00086321, 0x00063210,
+	0x000cb864, 0x000b8640, 0x000b8641, 0x00086410, 0x000b8642, 0x00086420,
+	0x00086421, 0x00064210, 0x000b8643, 0x00086430, 0x00086431, 0x00064310,
+	0x00086432, 0x00064320, 0x00064321, 0x00043210, 0x000cb865, 0x000b8650,
+	0x000b8651, 0x00086510, 0x000b8652, 0x00086520, 0x00086521, 0x00065210,
+	0x000b8653, 0x00086530, 0x00086531, 0x00065310, 0x00086532, 0x00065320,
+	0x00065321, 0x00053210, 0x000b8654, 0x00086540, 0x00086541, 0x00065410,
+	0x00086542, 0x00065420, 0x00065421, 0x00054210, 0x00086543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000cb870, 0x000cb870, 0x000cb871, 0x000b8710, 0x000cb872, 0x000b8720,
+	0x000b8721, 0x00087210, 0x000cb873, 0x000b8730, 0x000b8731, 0x00087310,
+	0x000b8732, 0x00087320, 0x00087321, 0x00073210, 0x000cb874, 0x000b8740,
+	0x000b8741, 0x00087410, 0x000b8742, 0x00087420, 0x00087421, 0x00074210,
+	0x000b8743, 0x00087430, 0x00087431, 0x00074310, 0x00087432, 0x00074320,
+	0x00074321, 0x00043210, 0x000cb875, 0x000b8750, 0x000b8751, 0x00087510,
+	0x000b8752, 0x00087520, 0x00087521, 0x00075210, 0x000b8753, 0x00087530,
+	0x00087531, 0x00075310, 0x00087532, 0x00075320, 0x00075321, 0x00053210,
+	0x000b8754, 0x00087540, 0x00087541, 0x00075410, 0x00087542, 0x00075420,
+	0x00075421, 0x00054210, 0x00087543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000cb876, 0x000b8760,
+	0x000b8761, 0x00087610, 0x000b8762, 0x00087620, 0x00087621, 0x00076210,
+	0x000b8763, 0x00087630, 0x00087631, 0x00076310, 0x00087632, 0x00076320,
+	0x00076321, 0x00063210, 0x000b8764, 0x00087640, 0x00087641, 0x00076410,
+	0x00087642, 0x00076420, 0x00076421, 0x00064210, 0x00087643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x000b8765, 0x00087650, 0x00087651, 0x00076510, 0x00087652, 0x00076520,
+	0x00076521, 0x00065210, 0x00087653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x00087654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000cb900, 0x000cb900, 0x000cb910, 0x000cb910,
+	0x000cb920, 0x000cb920, 0x000cb921, 0x000b9210, 0x000cb930, 0x000cb930,
+	0x000cb931, 0x000b9310, 0x000cb932, 0x000b9320, 0x000b9321, 0x00093210,
+	0x000cb940, 0x000cb940, 0x000cb941, 0x000b9410, 0x000cb942, 0x000b9420,
+	0x000b9421, 0x00094210, 0x000cb943, 0x000b9430, 0x000b9431, 0x00094310,
+	0x000b9432, 0x00094320, 0x00094321, 0x00043210, 0x000cb950, 0x000cb950,
+	0x000cb951, 0x000b9510, 0x000cb952, 0x000b9520, 0x000b9521, 0x00095210,
+	0x000cb953, 0x000b9530, 0x000b9531, 0x00095310, 0x000b9532, 0x00095320,
+	0x00095321, 0x00053210, 0x000cb954, 0x000b9540, 0x000b9541, 0x00095410,
+	0x000b9542, 0x00095420, 0x00095421, 0x00054210, 0x000b9543, 0x00095430,
+	0x00095431, 0x00054310, 0x00095432, 0x00054320, 0x00054321, 0x00043210,
+	0x000cb960, 0x000cb960, 0x000cb961, 0x000b9610, 0x000cb962, 0x000b9620,
+	0x000b9621, 0x00096210, 0x000cb963, 0x000b9630, 0x000b9631, 0x00096310,
+	0x000b9632, 0x00096320, 0x00096321, 0x00063210, 0x000cb964, 0x000b9640,
+	0x000b9641, 0x00096410, 0x000b9642, 0x00096420, 0x00096421, 0x00064210,
+	0x000b9643, 0x00096430, 0x00096431, 0x00064310, 0x00096432, 0x00064320,
+	0x00064321, 0x00043210, 0x000cb965, 0x000b9650, 0x000b9651, 0x00096510,
+	0x000b9652, 0x00096520, 0x00096521, 0x00065210, 0x000b9653, 0x00096530,
+	0x00096531, 0x00065310, 0x00096532, 0x00065320, 0x00065321, 0x00053210,
+	0x000b9654, 0x00096540, 0x00096541, 0x00065410, 0x00096542, 0x00065420,
+	0x00065421, 0x00054210, 0x00096543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000cb970, 0x000cb970,
+	0x000cb971, 0x000b9710, 0x000cb972, 0x000b9720, 0x000b9721, 0x00097210,
+	0x000cb973, 0x000b9730, 0x000b9731, 0x00097310, 0x000b9732, 0x00097320,
+	0x00097321, 0x00073210, 0x000cb974, 0x000b9740, 0x000b9741, 0x00097410,
+	0x000b9742, 0x00097420, 0x00097421, 0x00074210, 0x000b9743, 0x00097430,
+	0x00097431, 0x00074310, 0x00097432, 0x00074320, 0x00074321, 0x00043210,
+	0x000cb975, 0x000b9750, 0x000b9751, 0x00097510, 0x000b9752, 0x00097520,
+	0x00097521, 0x00075210, 0x000b9753, 0x00097530, 0x00097531, 0x00075310,
+	0x00097532, 0x00075320, 0x00075321, 0x00053210, 0x000b9754, 0x00097540,
+	0x00097541, 0x00075410, 0x00097542, 0x00075420, 0x00075421, 0x00054210,
+	0x00097543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x000cb976, 0x000b9760, 0x000b9761, 0x00097610,
+	0x000b9762, 0x00097620, 0x00097621, 0x00076210, 0x000b9763, 0x00097630,
+	0x00097631, 0x00076310, 0x00097632, 0x00076320, 0x00076321, 0x00063210,
+	0x000b9764, 0x00097640, 0x00097641, 0x00076410, 0x00097642, 0x00076420,
+	0x00076421, 0x00064210, 0x00097643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x000b9765, 0x00097650,
+	0x00097651, 0x00076510, 0x00097652, 0x00076520, 0x00076521, 0x00065210,
+	0x00097653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x00097654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000cb980, 0x000cb980, 0x000cb981, 0x000b9810, 0x000cb982, 0x000b9820,
+	0x000b9821, 0x00098210, 0x000cb983, 0x000b9830, 0x000b9831, 0x00098310,
+	0x000b9832, 0x00098320, 0x00098321, 0x00083210, 0x000cb984, 0x000b9840,
+	0x000b9841, 0x00098410, 0x000b9842, 0x00098420, 0x00098421, 0x00084210,
+	0x000b9843, 0x00098430, 0x00098431, 0x00084310, 0x00098432, 0x00084320,
+	0x00084321, 0x00043210, 0x000cb985, 0x000b9850, 0x000b9851, 0x00098510,
+	0x000b9852, 0x00098520, 0x00098521, 0x00085210, 0x000b9853, 0x00098530,
+	0x00098531, 0x00085310, 0x00098532, 0x00085320, 0x00085321, 0x00053210,
+	0x000b9854, 0x00098540, 0x00098541, 0x00085410, 0x00098542, 0x00085420,
+	0x00085421, 0x00054210, 0x00098543, 0x00085430, 0x00085431, 0x00054310,
+	0x00085432, 0x00054320, 0x00054321, 0x00043210, 0x000cb986, 0x000b9860,
+	0x000b9861, 0x00098610, 0x000b9862, 0x00098620, 0x00098621, 0x00086210,
+	0x000b9863, 0x00098630, 0x00098631, 0x00086310, 0x00098632, 0x00086320,
+	0x00086321, 0x00063210, 0x000b9864, 0x00098640, 0x00098641, 0x00086410,
+	0x00098642, 0x00086420, 0x00086421, 0x00064210, 0x00098643, 0x00086430,
+	0x00086431, 0x00064310, 0x00086432, 0x00064320, 0x00064321, 0x00043210,
+	0x000b9865, 0x00098650, 0x00098651, 0x00086510, 0x00098652, 0x00086520,
+	0x00086521, 0x00065210, 0x00098653, 0x00086530, 0x00086531, 0x00065310,
+	0x00086532, 0x00065320, 0x00065321, 0x00053210, 0x00098654, 0x00086540,
+	0x00086541, 0x00065410, 0x00086542, 0x00065420, 0x00065421, 0x00054210,
+	0x00086543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000cb987, 0x000b9870, 0x000b9871, 0x00098710,
+	0x000b9872, 0x00098720, 0x00098721, 0x00087210, 0x000b9873, 0x00098730,
+	0x00098731, 0x00087310, 0x00098732, 0x00087320, 0x00087321, 0x00073210,
+	0x000b9874, 0x00098740, 0x00098741, 0x00087410, 0x00098742, 0x00087420,
+	0x00087421, 0x00074210, 0x00098743, 0x00087430, 0x00087431, 0x00074310,
+	0x00087432, 0x00074320, 0x00074321, 0x00043210, 0x000b9875, 0x00098750,
+	0x00098751, 0x00087510, 0x00098752, 0x00087520, 0x00087521, 0x00075210,
+	0x00098753, 0x00087530, 0x00087531, 0x00075310, 0x00087532, 0x00075320,
+	0x00075321, 0x00053210, 0x00098754, 0x00087540, 0x00087541, 0x00075410,
+	0x00087542, 0x00075420, 0x00075421, 0x00054210, 0x00087543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x000b9876, 0x00098760, 0x00098761, 0x00087610, 0x00098762, 0x00087620,
+	0x00087621, 0x00076210, 0x00098763, 0x00087630, 0x00087631, 0x00076310,
+	0x00087632, 0x00076320, 0x00076321, 0x00063210, 0x00098764, 0x00087640,
+	0x00087641, 0x00076410, 0x00087642, 0x00076420, 0x00076421, 0x00064210,
+	0x00087643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x00098765, 0x00087650, 0x00087651, 0x00076510,
+	0x00087652, 0x00076520, 0x00076521, 0x00065210, 0x00087653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x00087654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000cba00, 0x000cba00,
+	0x000cba10, 0x000cba10, 0x000cba20, 0x000cba20, 0x000cba21, 0x000ba210,
+	0x000cba30, 0x000cba30, 0x000cba31, 0x000ba310, 0x000cba32, 0x000ba320,
+	0x000ba321, 0x000a3210, 0x000cba40, 0x000cba40, 0x000cba41, 0x000ba410,
+	0x000cba42, 0x000ba420, 0x000ba421, 0x000a4210, 0x000cba43, 0x000ba430,
+	0x000ba431, 0x000a4310, 0x000ba432, 0x000a4320, 0x000a4321, 0x00043210,
+	0x000cba50, 0x000cba50, 0x000cba51, 0x000ba510, 0x000cba52, 0x000ba520,
+	0x000ba521, 0x000a5210, 0x000cba53, 0x000ba530, 0x000ba531, 0x000a5310,
+	0x000ba532, 0x000a5320, 0x000a5321, 0x00053210, 0x000cba54, 0x000ba540,
+	0x000ba541, 0x000a5410, 0x000ba542, 0x000a5420, 0x000a5421, 0x00054210,
+	0x000ba543, 0x000a5430, 0x000a5431, 0x00054310, 0x000a5432, 0x00054320,
+	0x00054321, 0x00043210, 0x000cba60, 0x000cba60, 0x000cba61, 0x000ba610,
+	0x000cba62, 0x000ba620, 0x000ba621, 0x000a6210, 0x000cba63, 0x000ba630,
+	0x000ba631, 0x000a6310, 0x000ba632, 0x000a6320, 0x000a6321, 0x00063210,
+	0x000cba64, 0x000ba640, 0x000ba641, 0x000a6410, 0x000ba642, 0x000a6420,
+	0x000a6421, 0x00064210, 0x000ba643, 0x000a6430, 0x000a6431, 0x00064310,
+	0x000a6432, 0x00064320, 0x00064321, 0x00043210, 0x000cba65, 0x000ba650,
+	0x000ba651, 0x000a6510, 0x000ba652, 0x000a6520, 0x000a6521, 0x00065210,
+	0x000ba653, 0x000a6530, 0x000a6531, 0x00065310, 0x000a6532, 0x00065320,
+	0x00065321, 0x00053210, 0x000ba654, 0x000a6540, 0x000a6541, 0x00065410,
+	0x000a6542, 0x00065420, 0x00065421, 0x00054210, 0x000a6543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000cba70, 0x000cba70, 0x000cba71, 0x000ba710, 0x000cba72, 0x000ba720,
+	0x000ba721, 0x000a7210, 0x000cba73, 0x000ba730, 0x000ba731, 0x000a7310,
+	0x000ba732, 0x000a7320, 0x000a7321, 0x00073210, 0x000cba74, 0x000ba740,
+	0x000ba741, 0x000a7410, 0x000ba742, 0x000a7420, 0x000a7421, 0x00074210,
+	0x000ba743, 0x000a7430, 0x000a7431, 0x00074310, 0x000a7432, 0x00074320,
+	0x00074321, 0x00043210, 0x000cba75, 0x000ba750, 0x000ba751, 0x000a7510,
+	0x000ba752, 0x000a7520, 0x000a7521, 0x00075210, 0x000ba753, 0x000a7530,
+	0x000a7531, 0x00075310, 0x000a7532, 0x00075320, 0x00075321, 0x00053210,
+	0x000ba754, 0x000a7540, 0x000a7541, 0x00075410, 0x000a7542, 0x00075420,
+	0x00075421, 0x00054210, 0x000a7543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000cba76, 0x000ba760,
+	0x000ba761, 0x000a7610, 0x000ba762, 0x000a7620, 0x000a7621, 0x00076210,
+	0x000ba763, 0x000a7630, 0x000a7631, 0x00076310, 0x000a7632, 0x00076320,
+	0x00076321, 0x00063210, 0x000ba764, 0x000a7640, 0x000a7641, 0x00076410,
+	0x000a7642, 0x00076420, 0x00076421, 0x00064210, 0x000a7643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x000ba765, 0x000a7650, 0x000a7651, 0x00076510, 0x000a7652, 0x00076520,
+	0x00076521, 0x00065210, 0x000a7653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x000a7654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000cba80, 0x000cba80, 0x000cba81, 0x000ba810,
+	0x000cba82, 0x000ba820, 0x000ba821, 0x000a8210, 0x000cba83, 0x000ba830,
+	0x000ba831, 0x000a8310, 0x000ba832, 0x000a8320, 0x000a8321, 0x00083210,
+	0x000cba84, 0x000ba840, 0x000ba841, 0x000a8410, 0x000ba842, 0x000a8420,
+	0x000a8421, 0x00084210, 0x000ba843, 0x000a8430, 0x000a8431, 0x00084310,
+	0x000a8432, 0x00084320, 0x00084321, 0x00043210, 0x000cba85, 0x000ba850,
+	0x000ba851, 0x000a8510, 0x000ba852, 0x000a8520, 0x000a8521, 0x00085210,
+	0x000ba853, 0x000a8530, 0x000a8531, 0x00085310, 0x000a8532, 0x00085320,
+	0x00085321, 0x00053210, 0x000ba854, 0x000a8540, 0x000a8541, 0x00085410,
+	0x000a8542, 0x00085420, 0x00085421, 0x00054210, 0x000a8543, 0x00085430,
+	0x00085431, 0x00054310, 0x00085432, 0x00054320, 0x00054321, 0x00043210,
+	0x000cba86, 0x000ba860, 0x000ba861, 0x000a8610, 0x000ba862, 0x000a8620,
+	0x000a8621, 0x00086210, 0x000ba863, 0x000a8630, 0x000a8631, 0x00086310,
+	0x000a8632, 0x00086320, 0x00086321, 0x00063210, 0x000ba864, 0x000a8640,
+	0x000a8641, 0x00086410, 0x000a8642, 0x00086420, 0x00086421, 0x00064210,
+	0x000a8643, 0x00086430, 0x00086431, 0x00064310, 0x00086432, 0x00064320,
+	0x00064321, 0x00043210, 0x000ba865, 0x000a8650, 0x000a8651, 0x00086510,
+	0x000a8652, 0x00086520, 0x00086521, 0x00065210, 0x000a8653, 0x00086530,
+	0x00086531, 0x00065310, 0x00086532, 0x00065320, 0x00065321, 0x00053210,
+	0x000a8654, 0x00086540, 0x00086541, 0x00065410, 0x00086542, 0x00065420,
+	0x00065421, 0x00054210, 0x00086543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000cba87, 0x000ba870,
+	0x000ba871, 0x000a8710, 0x000ba872, 0x000a8720, 0x000a8721, 0x00087210,
+	0x000ba873, 0x000a8730, 0x000a8731, 0x00087310, 0x000a8732, 0x00087320,
+	0x00087321, 0x00073210, 0x000ba874, 0x000a8740, 0x000a8741, 0x00087410,
+	0x000a8742, 0x00087420, 0x00087421, 0x00074210, 0x000a8743, 0x00087430,
+	0x00087431, 0x00074310, 0x00087432, 0x00074320, 0x00074321, 0x00043210,
+	0x000ba875, 0x000a8750, 0x000a8751, 0x00087510, 0x000a8752, 0x00087520,
+	0x00087521, 0x00075210, 0x000a8753, 0x00087530, 0x00087531, 0x00075310,
+	0x00087532, 0x00075320, 0x00075321, 0x00053210, 0x000a8754, 0x00087540,
+	0x00087541, 0x00075410, 0x00087542, 0x00075420, 0x00075421, 0x00054210,
+	0x00087543, 0x00075430, 0x00075431, 0x00054310, 0x00075432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ba876, 0x000a8760, 0x000a8761, 0x00087610,
+	0x000a8762, 0x00087620, 0x00087621, 0x00076210, 0x000a8763, 0x00087630,
+	0x00087631, 0x00076310, 0x00087632, 0x00076320, 0x00076321, 0x00063210,
+	0x000a8764, 0x00087640, 0x00087641, 0x00076410, 0x00087642, 0x00076420,
+	0x00076421, 0x00064210, 0x00087643, 0x00076430, 0x00076431, 0x00064310,
+	0x00076432, 0x00064320, 0x00064321, 0x00043210, 0x000a8765, 0x00087650,
+	0x00087651, 0x00076510, 0x00087652, 0x00076520, 0x00076521, 0x00065210,
+	0x00087653, 0x00076530, 0x00076531, 0x00065310, 0x00076532, 0x00065320,
+	0x00065321, 0x00053210, 0x00087654, 0x00076540, 0x00076541, 0x00065410,
+	0x00076542, 0x00065420, 0x00065421, 0x00054210, 0x00076543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000cba90, 0x000cba90, 0x000cba91, 0x000ba910, 0x000cba92, 0x000ba920,
+	0x000ba921, 0x000a9210, 0x000cba93, 0x000ba930, 0x000ba931, 0x000a9310,
+	0x000ba932, 0x000a9320, 0x000a9321, 0x00093210, 0x000cba94, 0x000ba940,
+	0x000ba941, 0x000a9410, 0x000ba942, 0x000a9420, 0x000a9421, 0x00094210,
+	0x000ba943, 0x000a9430, 0x000a9431, 0x00094310, 0x000a9432, 0x00094320,
+	0x00094321, 0x00043210, 0x000cba95, 0x000ba950, 0x000ba951, 0x000a9510,
+	0x000ba952, 0x000a9520, 0x000a9521, 0x00095210, 0x000ba953, 0x000a9530,
+	0x000a9531, 0x00095310, 0x000a9532, 0x00095320, 0x00095321, 0x00053210,
+	0x000ba954, 0x000a9540, 0x000a9541, 0x00095410, 0x000a9542, 0x00095420,
+	0x00095421, 0x00054210, 0x000a9543, 0x00095430, 0x00095431, 0x00054310,
+	0x00095432, 0x00054320, 0x00054321, 0x00043210, 0x000cba96, 0x000ba960,
+	0x000ba961, 0x000a9610, 0x000ba962, 0x000a9620, 0x000a9621, 0x00096210,
+	0x000ba963, 0x000a9630, 0x000a9631, 0x00096310, 0x000a9632, 0x00096320,
+	0x00096321, 0x00063210, 0x000ba964, 0x000a9640, 0x000a9641, 0x00096410,
+	0x000a9642, 0x00096420, 0x00096421, 0x00064210, 0x000a9643, 0x00096430,
+	0x00096431, 0x00064310, 0x00096432, 0x00064320, 0x00064321, 0x00043210,
+	0x000ba965, 0x000a9650, 0x000a9651, 0x00096510, 0x000a9652, 0x00096520,
+	0x00096521, 0x00065210, 0x000a9653, 0x00096530, 0x00096531, 0x00065310,
+	0x00096532, 0x00065320, 0x00065321, 0x00053210, 0x000a9654, 0x00096540,
+	0x00096541, 0x00065410, 0x00096542, 0x00065420, 0x00065421, 0x00054210,
+	0x00096543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210, 0x000cba97, 0x000ba970, 0x000ba971, 0x000a9710,
+	0x000ba972, 0x000a9720, 0x000a9721, 0x00097210, 0x000ba973, 0x000a9730,
+	0x000a9731, 0x00097310, 0x000a9732, 0x00097320, 0x00097321, 0x00073210,
+	0x000ba974, 0x000a9740, 0x000a9741, 0x00097410, 0x000a9742, 0x00097420,
+	0x00097421, 0x00074210, 0x000a9743, 0x00097430, 0x00097431, 0x00074310,
+	0x00097432, 0x00074320, 0x00074321, 0x00043210, 0x000ba975, 0x000a9750,
+	0x000a9751, 0x00097510, 0x000a9752, 0x00097520, 0x00097521, 0x00075210,
+	0x000a9753, 0x00097530, 0x00097531, 0x00075310, 0x00097532, 0x00075320,
+	0x00075321, 0x00053210, 0x000a9754, 0x00097540, 0x00097541, 0x00075410,
+	0x00097542, 0x00075420, 0x00075421, 0x00054210, 0x00097543, 0x00075430,
+	0x00075431, 0x00054310, 0x00075432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ba976, 0x000a9760, 0x000a9761, 0x00097610, 0x000a9762, 0x00097620,
+	0x00097621, 0x00076210, 0x000a9763, 0x00097630, 0x00097631, 0x00076310,
+	0x00097632, 0x00076320, 0x00076321, 0x00063210, 0x000a9764, 0x00097640,
+	0x00097641, 0x00076410, 0x00097642, 0x00076420, 0x00076421, 0x00064210,
+	0x00097643, 0x00076430, 0x00076431, 0x00064310, 0x00076432, 0x00064320,
+	0x00064321, 0x00043210, 0x000a9765, 0x00097650, 0x00097651, 0x00076510,
+	0x00097652, 0x00076520, 0x00076521, 0x00065210, 0x00097653, 0x00076530,
+	0x00076531, 0x00065310, 0x00076532, 0x00065320, 0x00065321, 0x00053210,
+	0x00097654, 0x00076540, 0x00076541, 0x00065410, 0x00076542, 0x00065420,
+	0x00065421, 0x00054210, 0x00076543, 0x00065430, 0x00065431, 0x00054310,
+	0x00065432, 0x00054320, 0x00054321, 0x00043210, 0x000cba98, 0x000ba980,
+	0x000ba981, 0x000a9810, 0x000ba982, 0x000a9820, 0x000a9821, 0x00098210,
+	0x000ba983, 0x000a9830, 0x000a9831, 0x00098310, 0x000a9832, 0x00098320,
+	0x00098321, 0x00083210, 0x000ba984, 0x000a9840, 0x000a9841, 0x00098410,
+	0x000a9842, 0x00098420, 0x00098421, 0x00084210, 0x000a9843, 0x00098430,
+	0x00098431, 0x00084310, 0x00098432, 0x00084320, 0x00084321, 0x00043210,
+	0x000ba985, 0x000a9850, 0x000a9851, 0x00098510, 0x000a9852, 0x00098520,
+	0x00098521, 0x00085210, 0x000a9853, 0x00098530, 0x00098531, 0x00085310,
+	0x00098532, 0x00085320, 0x00085321, 0x00053210, 0x000a9854, 0x00098540,
+	0x00098541, 0x00085410, 0x00098542, 0x00085420, 0x00085421, 0x00054210,
+	0x00098543, 0x00085430, 0x00085431, 0x00054310, 0x00085432, 0x00054320,
+	0x00054321, 0x00043210, 0x000ba986, 0x000a9860, 0x000a9861, 0x00098610,
+	0x000a9862, 0x00098620, 0x00098621, 0x00086210, 0x000a9863, 0x00098630,
+	0x00098631, 0x00086310, 0x00098632, 0x00086320, 0x00086321, 0x00063210,
+	0x000a9864, 0x00098640, 0x00098641, 0x00086410, 0x00098642, 0x00086420,
+	0x00086421, 0x00064210, 0x00098643, 0x00086430, 0x00086431, 0x00064310,
+	0x00086432, 0x00064320, 0x00064321, 0x00043210, 0x000a9865, 0x00098650,
+	0x00098651, 0x00086510, 0x00098652, 0x00086520, 0x00086521, 0x00065210,
+	0x00098653, 0x00086530, 0x00086531, 0x00065310, 0x00086532, 0x00065320,
+	0x00065321, 0x00053210, 0x00098654, 0x00086540, 0x00086541, 0x00065410,
+	0x00086542, 0x00065420, 0x00065421, 0x00054210, 0x00086543, 0x00065430,
+	0x00065431, 0x00054310, 0x00065432, 0x00054320, 0x00054321, 0x00043210,
+	0x000ba987, 0x000a9870, 0x000a9871, 0x00098710, 0x000a9872, 0x00098720,
+	0x00098721, 0x00087210, 0x000a9873, 0x00098730, 0x00098731, 0x00087310,
+	0x00098732, 0x00087320, 0x00087321, 0x00073210, 0x000a9874, 0x00098740,
+	0x00098741, 0x00087410, 0x00098742, 0x00087420, 0x00087421, 0x00074210,
+	0x00098743, 0x00087430, 0x00087431, 0x00074310, 0x00087432, 0x00074320,
+	0x00074321, 0x00043210, 0x000a9875, 0x00098750, 0x00098751, 0x00087510,
+	0x00098752, 0x00087520, 0x00087521, 0x00075210, 0x00098753, 0x00087530,
+	0x00087531, 0x00075310, 0x00087532, 0x00075320, 0x00075321, 0x00053210,
+	0x00098754, 0x00087540, 0x00087541, 0x00075410, 0x00087542, 0x00075420,
+	0x00075421, 0x00054210, 0x00087543, 0x00075430, 0x00075431, 0x00054310,
+	0x00075432, 0x00054320, 0x00054321, 0x00043210, 0x000a9876, 0x00098760,
+	0x00098761, 0x00087610, 0x00098762, 0x00087620, 0x00087621, 0x00076210,
+	0x00098763, 0x00087630, 0x00087631, 0x00076310, 0x00087632, 0x00076320,
+	0x00076321, 0x00063210, 0x00098764, 0x00087640, 0x00087641, 0x00076410,
+	0x00087642, 0x00076420, 0x00076421, 0x00064210, 0x00087643, 0x00076430,
+	0x00076431, 0x00064310, 0x00076432, 0x00064320, 0x00064321, 0x00043210,
+	0x00098765, 0x00087650, 0x00087651, 0x00076510, 0x00087652, 0x00076520,
+	0x00076521, 0x00065210, 0x00087653, 0x00076530, 0x00076531, 0x00065310,
+	0x00076532, 0x00065320, 0x00065321, 0x00053210, 0x00087654, 0x00076540,
+	0x00076541, 0x00065410, 0x00076542, 0x00065420, 0x00065421, 0x00054210,
+	0x00076543, 0x00065430, 0x00065431, 0x00054310, 0x00065432, 0x00054320,
+	0x00054321, 0x00043210,
+}
+
+var shortStraightTable = []uint16{
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08,
+	0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x08, 0x08, 0x08,
+	0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08,
+	0x08, 0x08, 0x08, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08,
+	0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b, 0x0b,
+	0x0b, 0x0b, 0x0b, 0x0b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x08, 0x08, 0x08,
+	0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08,
+	0x08, 0x08, 0x08, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08,
+	0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x08, 0x08, 0x08,
+	0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08, 0x08,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x05, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x06, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07, 0x07,
+	0x07, 0x07, 0x07, 0x07, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+	0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c, 0x0c,
+}
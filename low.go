@@ -0,0 +1,184 @@
+package holdem
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// LowValue ranks a hand for lowball, where the lowest hand wins: the lower
+// the value, the better the hand. It uses the layout of HandValue, but is
+// only comparable with values of the same kind of lowball.
+type LowValue uint32
+
+// aceLowFlag marks ace-to-five values, whose ranks count the ace as one.
+const aceLowFlag = 1 << 28
+
+// aceLow moves the ace of a rank mask below the two.
+func aceLow(ranks uint32) uint32 {
+	return ((ranks << 1) | (ranks >> 12)) & 0x1FFF
+}
+
+// AceToFive values the best five cards of the hand for ace-to-five
+// lowball, as played in Razz: aces are low, straights and flushes do not
+// count, and pairs do. The best hand is 5-4-3-2-A. The hand must have at
+// least five cards.
+func (h Hand) AceToFive() LowValue {
+	sc := aceLow(uint32(h>>clubOffset) & 0x1FFF)
+	sd := aceLow(uint32(h>>diamondOffset) & 0x1FFF)
+	sh := aceLow(uint32(h>>heartOffset) & 0x1FFF)
+	ss := aceLow(uint32(h>>spadeOffset) & 0x1FFF)
+
+	values := sc | sd | sh | ss
+	if nBitsTable[values] >= 5 {
+		return LowValue(aceLowFlag | bottomFiveCardsTable[values])
+	}
+
+	// Ranks with at least two, three and four cards.
+	twoMask := (sc & sd) | (sc & sh) | (sc & ss) | (sd & sh) | (sd & ss) | (sh & ss)
+	threeMask := ((sc & sd) | (sh & ss)) & ((sc & sh) | (sd & ss))
+	fourMask := sc & sd & sh & ss
+
+	// Every rank plays once, and the lowest ranks pair up to make five
+	// cards, since fewer pairs are better.
+	switch extra := 5 - int(nBitsTable[values]); {
+	case extra == 1:
+		pair := lowestRank(twoMask)
+		return LowValue(aceLowFlag | uint32(Pair)<<handTypeShift | pair<<topCardShift |
+			bottomFiveCardsTable[values^(1<<pair)]>>cardWidth)
+	case extra == 2 && nBitsTable[twoMask] >= 2:
+		low := lowestRank(twoMask)
+		high := lowestRank(twoMask ^ (1 << low))
+		kicker := values ^ (1 << low) ^ (1 << high)
+		return LowValue(aceLowFlag | uint32(TwoPair)<<handTypeShift | high<<topCardShift |
+			low<<secondCardShift | uint32(topCardTable[kicker])<<thirdCardShift)
+	case extra == 2:
+		trips := lowestRank(threeMask)
+		return LowValue(aceLowFlag | uint32(Trips)<<handTypeShift | trips<<topCardShift |
+			bottomFiveCardsTable[values^(1<<trips)]>>cardWidth)
+	case threeMask != 0 && nBitsTable[twoMask] == 2:
+		trips := lowestRank(threeMask)
+		pair := uint32(topCardTable[twoMask^(1<<trips)])
+		return LowValue(aceLowFlag | uint32(FullHouse)<<handTypeShift | trips<<topCardShift |
+			pair<<secondCardShift)
+	default:
+		quads := lowestRank(fourMask)
+		kicker := uint32(topCardTable[values^(1<<quads)])
+		return LowValue(aceLowFlag | uint32(FourOfAKind)<<handTypeShift | quads<<topCardShift |
+			kicker<<secondCardShift)
+	}
+}
+
+// EightOrBetter returns the ace-to-five value of the hand, and whether it
+// qualifies for the low half of a hi/lo pot: five different ranks, none
+// above an eight.
+func (h Hand) EightOrBetter() (LowValue, bool) {
+	v := h.AceToFive()
+	return v, v.Class() == HighCard && v.TopCard() <= 7
+}
+
+// DeuceToSeven values the best five cards of the hand for deuce-to-seven
+// lowball: aces are high, and straights, flushes and pairs all count
+// against the hand. The best hand is 7-5-4-3-2 offsuit. Hands of more than
+// five cards take the best five of them.
+func (h Hand) DeuceToSeven() LowValue {
+	if countBits(uint64(h)) <= 5 {
+		return deuceToSeven(h)
+	}
+
+	best := LowValue(^uint32(0))
+	cards := h.Cards()
+	var walk func(start, n int, sub Hand)
+	walk = func(start, n int, sub Hand) {
+		if n == 5 {
+			if v := deuceToSeven(sub); v < best {
+				best = v
+			}
+			return
+		}
+
+		for i := start; i <= len(cards)-(5-n); i++ {
+			walk(i+1, n+1, sub|Hand(cardMasksTable[cards[i]]))
+		}
+	}
+	walk(0, 0, 0)
+
+	return best
+}
+
+// deuceToSeven values five cards, which only differs from their high value
+// when they make a wheel: A-5-4-3-2 is ace high, not a straight.
+func deuceToSeven(h Hand) LowValue {
+	v := h.Value()
+	if cls := v.Class(); (cls == Straight || cls == StraightFlush) && v.TopCard() == 3 {
+		values := uint32(h|h>>13|h>>26|h>>39) & 0x1FFF
+		if cls == Straight {
+			return LowValue(highCardVal + topFiveCardsTable[values])
+		}
+		return LowValue(flushVal + topFiveCardsTable[values])
+	}
+
+	return LowValue(v)
+}
+
+// lowestRank returns the lowest rank in a rank mask.
+func lowestRank(ranks uint32) uint32 {
+	return uint32(topCardTable[ranks&-ranks])
+}
+
+// Class returns the pairs, or for deuce-to-seven also the straight or
+// flush, the hand is burdened with. The best low hands are HighCard.
+func (v LowValue) Class() HandClass {
+	return HandClass((v &^ aceLowFlag) >> handTypeShift)
+}
+
+// TopCard returns the rank of the highest group of cards: the highest card
+// of a hand with no pair, or the rank of the pair. Ace-to-five ranks count
+// from zero for the ace, and deuce-to-seven ranks from zero for the two,
+// like Card.Value.
+func (v LowValue) TopCard() int {
+	return int(v>>topCardShift) & 0xF
+}
+
+// String changes a LowValue into a readable representation, such as
+// "7-5-4-3-2", or "3-3-5-2-A" for an ace-to-five hand with a pair.
+// Deuce-to-seven hands with a pair or better read like a HandValue.
+func (v LowValue) String() string {
+	cls := v.Class()
+	if v&aceLowFlag == 0 && cls != HighCard {
+		return HandValue(v).String()
+	}
+
+	// How often each rank in the value appears.
+	var counts []int
+	switch cls {
+	case Pair:
+		counts = []int{2, 1, 1, 1}
+	case TwoPair:
+		counts = []int{2, 2, 1}
+	case Trips:
+		counts = []int{3, 1, 1}
+	case FullHouse:
+		counts = []int{3, 2}
+	case FourOfAKind:
+		counts = []int{4, 1}
+	default:
+		counts = []int{1, 1, 1, 1, 1}
+	}
+
+	b := &bytes.Buffer{}
+	for i, n := range counts {
+		rank := int(v>>(topCardShift-cardWidth*uint(i))) & 0xF
+		if v&aceLowFlag != 0 {
+			rank = (rank + 12) % 13
+		}
+
+		for ; n > 0; n-- {
+			if b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			fmt.Fprintf(b, "%-v", Card(rank))
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,155 @@
+package holdem
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestHand_AceToFive(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Cards  string
+		Expect string
+	}{
+		{"as 2d 3h 4c 5s", "5-4-3-2-A"},
+		{"as 2s 3s 4s 5s", "5-4-3-2-A"},
+		{"kd ks 2c 3h 4d 7s 8c", "8-7-4-3-2"},
+		{"ac ad 2c 2d 3h 3s 4c", "A-A-4-3-2"},
+		{"ac ad 2c 2d 3h 3s 9c", "A-A-9-3-2"},
+		{"ac ad ah 2d 2h 3s 3c", "2-2-A-A-3"},
+		{"ac ad ah 2d 3s", "A-A-A-3-2"},
+		{"kc kd kh 2d 2s 2h", "2-2-2-K-K"},
+		{"kc kd kh ks 2d", "K-K-K-K-2"},
+	}
+
+	for _, c := range cases {
+		if got := NewHandStr(c.Cards).AceToFive().String(); got != c.Expect {
+			t.Errorf(`%s: expected: "%s", got: "%s"`, c.Cards, c.Expect, got)
+		}
+	}
+
+	// Each hand beats the one after it.
+	order := []string{
+		"5c 4d 3h 2s ac",
+		"6c 4d 3h 2s ac",
+		"6c 5d 3h 2s ac",
+		"7c 4d 3h 2s ac",
+		"8c 7d 6h 5s 4c",
+		"kc qd jh 10s 9c",
+		"ac ad 4h 3s 2c",
+		"2c 2d kh qs jc",
+		"ac ad 2h 2s 3c",
+		"ac ad ah 2s 3c",
+		"ac ad ah 2s 2c",
+		"ac ad ah as 2c",
+	}
+	for i := 1; i < len(order); i++ {
+		a, b := NewHandStr(order[i-1]).AceToFive(), NewHandStr(order[i]).AceToFive()
+		if a >= b {
+			t.Errorf("Expected %s (%v) to beat %s (%v)", order[i-1], a, order[i], b)
+		}
+	}
+}
+
+func TestHand_DeuceToSeven(t *testing.T) {
+	t.Parallel()
+
+	// Each hand beats the one after it.
+	order := []string{
+		"7c 5d 4h 3s 2c",
+		"7c 6d 4h 3s 2c",
+		"8c 6d 4h 3s 2c",
+		"kc 5d 4h 3s 2c",
+		"ac 5d 4h 3s 2c",
+		"ac kd qh js 9c",
+		"2c 2d 5h 4s 3c",
+		"ac ad kh qs jc",
+		"2c 2d 3h 3s 4c",
+		"7c 6d 5h 4s 3c",
+		"7c 5c 4c 3c 2c",
+		"ac 5c 4c 3c 2c",
+		"2c 2d 2h 3s 3c",
+		"7c 6c 5c 4c 3c",
+	}
+	for i := 1; i < len(order); i++ {
+		a, b := NewHandStr(order[i-1]).DeuceToSeven(), NewHandStr(order[i]).DeuceToSeven()
+		if a >= b {
+			t.Errorf("Expected %s (%v) to beat %s (%v)", order[i-1], a, order[i], b)
+		}
+	}
+
+	cases := []struct {
+		Cards  string
+		Expect string
+	}{
+		{"7c 5d 4h 3s 2c", "7-5-4-3-2"},
+		{"ac 5d 4h 3s 2c", "A-5-4-3-2"},
+		{"7c 6d 5h 4s 3c", "Straight with 7 high"},
+		{"kc kd 7h 5s 4c 3d 2h", "7-5-4-3-2"},
+	}
+	for _, c := range cases {
+		if got := NewHandStr(c.Cards).DeuceToSeven().String(); got != c.Expect {
+			t.Errorf(`%s: expected: "%s", got: "%s"`, c.Cards, c.Expect, got)
+		}
+	}
+}
+
+func TestHand_EightOrBetter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Cards   string
+		Qualify bool
+	}{
+		{"as 2d 3h 4c 8s 9d kc", true},
+		{"as 2d 3h 9c 9s kd kc", false},
+		{"as ad 2c 3d 4h 5s 5c", true},
+		{"2s 3d 4h 5c 9s 9d kc", false},
+		{"as ad 2c 2d 3h 3s 4c", false},
+	}
+
+	for _, c := range cases {
+		if _, ok := NewHandStr(c.Cards).EightOrBetter(); ok != c.Qualify {
+			t.Errorf("%s: expected: %v, got: %v", c.Cards, c.Qualify, ok)
+		}
+	}
+}
+
+func TestHand_LowSevenCards(t *testing.T) {
+	t.Parallel()
+
+	// Seven card values must be the best of their five card subsets.
+	deck := NewDeck(rand.NewSource(19))
+	for n := 0; n < 20000; n++ {
+		deck.Reset()
+		deck.Shuffle()
+		cards := deck.Deal(7)
+
+		a5, d7 := LowValue(^uint32(0)), LowValue(^uint32(0))
+		eachFive(cards, func(five []Card) {
+			sub := NewHandCards(five)
+			if v := sub.AceToFive(); v < a5 {
+				a5 = v
+			}
+			if v := sub.DeuceToSeven(); v < d7 {
+				d7 = v
+			}
+		})
+
+		h := NewHandCards(cards)
+		if got := h.AceToFive(); got != a5 {
+			t.Fatalf("%v: expected: %v, got: %v", cards, a5, got)
+		}
+		if got := h.DeuceToSeven(); got != d7 {
+			t.Fatalf("%v: expected: %v, got: %v", cards, d7, got)
+		}
+	}
+}
+
+func BenchmarkHand_AceToFive(b *testing.B) {
+	hand := NewHandStr("ad as 3d 5d 7h 10d 10c")
+	for i := 0; i < b.N; i++ {
+		hand.AceToFive()
+	}
+}
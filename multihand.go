@@ -0,0 +1,166 @@
+package holdem
+
+// MultiHand is a hand that can hold the same card more than once, as dealt
+// from a game with several decks. It counts the copies of every card.
+type MultiHand [DeckSize]uint8
+
+// NewMultiHand creates a hand from the cards given, keeping duplicates.
+func NewMultiHand(cards ...[]Card) MultiHand {
+	var hand MultiHand
+
+	for _, cardArr := range cards {
+		for _, card := range cardArr {
+			hand[card]++
+		}
+	}
+
+	return hand
+}
+
+// Len returns the number of cards in the hand.
+func (m MultiHand) Len() int {
+	n := 0
+	for _, c := range m {
+		n += int(c)
+	}
+
+	return n
+}
+
+// Value computes the value of the best five cards in the hand. It uses the
+// same encoding as Hand.Value, so hands without duplicates score the same.
+// Five cards of one rank make FiveOfAKind, which beats a straight flush,
+// and a flush can include a pair of identical cards, which then count as
+// two of its five cards.
+func (m MultiHand) Value() HandValue {
+	v, _ := m.value(straightTable)
+	return v
+}
+
+// value computes the value of the hand, finding straights with straights
+// like Hand.valueCards. It also returns the value of the best flush, or 0,
+// which a full house may hide.
+func (m MultiHand) value(straights []uint16) (HandValue, HandValue) {
+	var ranks [13]int
+	var suits [4][13]int
+	var suitCount [4]int
+
+	for i, n := range m {
+		c := Card(i)
+		ranks[c.Value()] += int(n)
+		suits[c.Suit()][c.Value()] += int(n)
+		suitCount[c.Suit()] += int(n)
+	}
+
+	var flush HandValue
+	for s := range suits {
+		if suitCount[s] < 5 {
+			continue
+		}
+
+		v, shift, left := flushVal, uint32(topCardShift), 5
+		for r := 12; r >= 0 && left > 0; r-- {
+			for n := 0; n < suits[s][r] && left > 0; n++ {
+				v += uint32(r) << shift
+				shift -= cardWidth
+				left--
+			}
+		}
+		if HandValue(v) > flush {
+			flush = HandValue(v)
+		}
+	}
+
+	if r := topRank(&ranks, 5, -1); r >= 0 {
+		return HandValue(fiveOfAKindVal + uint32(r)<<topCardShift), flush
+	}
+
+	var st uint16
+	for s := range suits {
+		if v := straights[rankMask(&suits[s])]; v > st {
+			st = v
+		}
+	}
+	if st != 0 {
+		return HandValue(straightFlushVal + uint32(st)<<topCardShift), flush
+	}
+
+	if q := topRank(&ranks, 4, -1); q >= 0 {
+		return HandValue(fourOfAKindVal + uint32(q)<<topCardShift +
+			kickers(&ranks, 1, secondCardShift, q, -1)), flush
+	}
+
+	t := topRank(&ranks, 3, -1)
+	if t >= 0 {
+		if p := topRank(&ranks, 2, t); p >= 0 {
+			return HandValue(fullHouseVal + uint32(t)<<topCardShift + uint32(p)<<secondCardShift), flush
+		}
+	}
+
+	if flush != 0 {
+		return flush, flush
+	}
+
+	if v := straights[rankMask(&ranks)]; v != 0 {
+		return HandValue(straightVal + uint32(v)<<topCardShift), flush
+	}
+
+	if t >= 0 {
+		return HandValue(tripsVal + uint32(t)<<topCardShift +
+			kickers(&ranks, 2, secondCardShift, t, -1)), flush
+	}
+
+	if p := topRank(&ranks, 2, -1); p >= 0 {
+		if p2 := topRank(&ranks, 2, p); p2 >= 0 {
+			return HandValue(twoPairVal + uint32(p)<<topCardShift + uint32(p2)<<secondCardShift +
+				kickers(&ranks, 1, thirdCardShift, p, p2)), flush
+		}
+
+		return HandValue(pairVal + uint32(p)<<topCardShift +
+			kickers(&ranks, 3, secondCardShift, p, -1)), flush
+	}
+
+	return HandValue(highCardVal + kickers(&ranks, 5, topCardShift, -1, -1)), flush
+}
+
+// topRank returns the highest rank held at least n times, other than skip,
+// or -1 if there is none.
+func topRank(ranks *[13]int, n, skip int) int {
+	for r := 12; r >= 0; r-- {
+		if r != skip && ranks[r] >= n {
+			return r
+		}
+	}
+
+	return -1
+}
+
+// kickers encodes the n highest ranks held, other than skip1 and skip2,
+// from shift downwards.
+func kickers(ranks *[13]int, n int, shift uint32, skip1, skip2 int) uint32 {
+	var v uint32
+
+	for r := 12; r >= 0 && n > 0; r-- {
+		if r == skip1 || r == skip2 || ranks[r] == 0 {
+			continue
+		}
+
+		v += uint32(r) << shift
+		shift -= cardWidth
+		n--
+	}
+
+	return v
+}
+
+func rankMask(ranks *[13]int) uint32 {
+	var mask uint32
+
+	for r, n := range ranks {
+		if n > 0 {
+			mask |= 1 << uint(r)
+		}
+	}
+
+	return mask
+}
@@ -0,0 +1,102 @@
+package holdem
+
+import (
+	"strings"
+	"testing"
+)
+
+func newMultiHandStr(t *testing.T, str string) MultiHand {
+	var cards []Card
+	for _, f := range strings.Fields(str) {
+		c, err := ParseCard(f)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		cards = append(cards, c)
+	}
+
+	return NewMultiHand(cards)
+}
+
+func TestMultiHand_Value(t *testing.T) {
+	t.Parallel()
+
+	hands := []struct {
+		Cards string
+		Class HandClass
+		Str   string
+	}{
+		{"as as ah ac ad 2c 3d", FiveOfAKind, "Five of a kind: A's"},
+		{"9h 9h 10h jh qh kh 2c", StraightFlush, "Straight flush with K high"},
+		{"7c 7c 7d 7s 7h", FiveOfAKind, "Five of a kind: 7's"},
+		{"kd kd kd kh 2c 2c 3s", FourOfAKind, "Four of a kind: K's with 3 kicker"},
+		{"kd kd kh 2c 2c 3s 4s", FullHouse, "Full house: K's and 2's"},
+		{"as as ks qs 9s 2c 2c", Flush, "Flush: A, A, K, Q, 9"},
+		{"5d 5d 6c 7h 8s 9s 9s", Straight, "Straight with 9 high"},
+		{"qd qd qh 2c 3s 4s 9h", Trips, "Three of a kind: Q's"},
+		{"qd qd 2c 2c 3s 4s 9h", TwoPair, "Two pair: Q's and 2's with 9 kicker"},
+		{"qd qd 2c 5c 3s 4h 9h", Pair, "One pair: Q"},
+		{"qd jd 2c 5c 3s 4h 9h", HighCard, "High card: Q"},
+	}
+
+	for _, h := range hands {
+		v := newMultiHandStr(t, h.Cards).Value()
+		if v.Class() != h.Class {
+			t.Errorf("%s: expected class %v, got: %v", h.Cards, h.Class, v.Class())
+		}
+		if got := v.String(); h.Str != "" && got != h.Str {
+			t.Errorf(`%s: expected: "%s", got: "%s"`, h.Cards, h.Str, got)
+		}
+	}
+}
+
+func TestMultiHand_Order(t *testing.T) {
+	t.Parallel()
+
+	// Each hand beats the one after it.
+	hands := []string{
+		"2c 2c 2d 2h 2s",
+		"as ks qs js 10s",
+		"as as ks qs js 9s 9s",
+		"as ks qs js 9s",
+		"as kd qs js 10h",
+		"ac ac ad kh qs",
+		"ac ac kd kh qs",
+		"ac ac kd jh qs",
+		"ac qc kd jh 9s",
+	}
+
+	for i := 1; i < len(hands); i++ {
+		a := newMultiHandStr(t, hands[i-1]).Value()
+		b := newMultiHandStr(t, hands[i]).Value()
+		if a <= b {
+			t.Errorf("Expected %s (%v) to beat %s (%v)", hands[i-1], a, hands[i], b)
+		}
+	}
+}
+
+func TestMultiHand_SingleDeck(t *testing.T) {
+	t.Parallel()
+
+	hands := []string{
+		"ad kd 2d kh qd 3h qc",
+		"ad ac as 3d 4h 10h 10d",
+		"2d 3d 4d 5d 6d 7d 8d",
+		"10h jh qh kh 7s 10c ah",
+		"2c 3d 8h 9s jc qd kh",
+		"2c 2d 2h 2s jc qd kh",
+		"as ks 9s 7s 2s 2c 3d",
+	}
+
+	for _, str := range hands {
+		cards, _ := ParseCards(str)
+		m := NewMultiHand(cards)
+		if exp, got := len(cards), m.Len(); exp != got {
+			t.Errorf("Expected: %v, got: %v", exp, got)
+		}
+
+		if exp, got := NewHandStr(str).Value(), m.Value(); exp != got {
+			t.Errorf("%s: expected: %v, got: %v", str, exp, got)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package holdem
+
+// EvaluateOmaha scores the best hand made of exactly two of the hole cards
+// and three of the board, as Omaha requires. It takes any number of hole
+// cards, usually four, five or six, and a board of at most five. Before
+// the flop it uses every board card there is. Use EvaluateOmahaMulti when
+// cards can be duplicated, as dealt from several decks.
+func EvaluateOmaha(hole, board []Card) HandValue {
+	v, _, _ := bestOmaha(hole, board)
+	return v
+}
+
+// BestOmaha is like EvaluateOmaha, but also returns the five cards used:
+// the two hole cards first, then the board cards, each in the order given.
+// It returns no cards if there are fewer than two hole cards.
+func BestOmaha(hole, board []Card) (HandValue, []Card) {
+	v, holeIdx, boardIdx := bestOmaha(hole, board)
+	if len(hole) < 2 {
+		return 0, nil
+	}
+
+	used := []Card{hole[holeIdx[0]], hole[holeIdx[1]]}
+	for _, i := range boardIdx {
+		used = append(used, board[i])
+	}
+
+	return v, used
+}
+
+// EvaluateOmahaMulti is like EvaluateOmaha for games with several decks,
+// where the hole cards and the board may hold the same card more than once.
+func EvaluateOmahaMulti(hole, board []Card) HandValue {
+	if len(hole) < 2 {
+		return 0
+	}
+
+	boards := newOmahaBoards(board)
+	five := make([]Card, 0, 5)
+	var best HandValue
+	for i := 0; i < len(hole); i++ {
+		for j := i + 1; j < len(hole); j++ {
+			for _, idx := range boards.idx[:boards.n] {
+				five = append(five[:0], hole[i], hole[j])
+				for _, b := range idx[:boards.pick] {
+					five = append(five, board[b])
+				}
+				if v := NewMultiHand(five).Value(); v > best {
+					best = v
+				}
+			}
+		}
+	}
+
+	return best
+}
+
+// bestOmaha returns the best value, and the indices of the hole and board
+// cards that make it. The board may have at most five cards.
+func bestOmaha(hole, board []Card) (HandValue, [2]int, []int) {
+	if len(hole) < 2 {
+		return 0, [2]int{}, nil
+	}
+
+	boards := newOmahaBoards(board)
+
+	var best HandValue
+	var bestHole [2]int
+	bestBoard := -1
+	for i := 0; i < len(hole); i++ {
+		for j := i + 1; j < len(hole); j++ {
+			h := Hand(cardMasksTable[hole[i]] | cardMasksTable[hole[j]])
+			for k, b := range boards.masks[:boards.n] {
+				if v := (h | b).ValueCards(2 + boards.pick); bestBoard < 0 || v > best {
+					best, bestHole, bestBoard = v, [2]int{i, j}, k
+				}
+			}
+		}
+	}
+
+	return best, bestHole, boards.idx[bestBoard][:boards.pick]
+}
+
+// EvaluateOmahaEightOrBetter returns the best ace-to-five low made of
+// exactly two of the hole cards and three of the board, and whether any
+// such low qualifies for Omaha Hi/Lo: five different ranks, none above an
+// eight. There is no low before the flop.
+func EvaluateOmahaEightOrBetter(hole, board []Card) (LowValue, bool) {
+	if len(board) < 3 {
+		return 0, false
+	}
+
+	boards := newOmahaBoards(board)
+	var best LowValue
+	found := false
+	for i := 0; i < len(hole); i++ {
+		for j := i + 1; j < len(hole); j++ {
+			h := Hand(cardMasksTable[hole[i]] | cardMasksTable[hole[j]])
+			for _, b := range boards.masks[:boards.n] {
+				if v, ok := (h | b).EightOrBetter(); ok && (!found || v < best) {
+					best, found = v, true
+				}
+			}
+		}
+	}
+
+	return best, found
+}
+
+// omahaBoards holds every way to pick three cards from the board, as masks
+// and indices. Five board cards give at most ten ways. A board of fewer
+// than three cards is picked whole.
+type omahaBoards struct {
+	masks [10]Hand
+	idx   [10][3]int
+	n     int
+	pick  int
+}
+
+func newOmahaBoards(board []Card) omahaBoards {
+	var o omahaBoards
+	if len(board) < 3 {
+		o.masks[0] = NewHandCards(board)
+		o.idx[0] = [3]int{0, 1, 2}
+		o.n, o.pick = 1, len(board)
+		return o
+	}
+
+	o.pick = 3
+	for a := 0; a < len(board); a++ {
+		for b := a + 1; b < len(board); b++ {
+			for c := b + 1; c < len(board); c++ {
+				o.masks[o.n] = Hand(cardMasksTable[board[a]] | cardMasksTable[board[b]] | cardMasksTable[board[c]])
+				o.idx[o.n] = [3]int{a, b, c}
+				o.n++
+			}
+		}
+	}
+
+	return o
+}
@@ -0,0 +1,143 @@
+package holdem
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestEvaluateOmaha(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Hole  string
+		Board string
+		Class HandClass
+		Used  string
+	}{
+		// A straight flush on the board plays only with two spades in hand.
+		{"as 2d 3c 4h", "ks qs js 10s 9s", HighCard, "as 4h ks qs js"},
+		{"as ks qd jd", "2s 3s 4s 9h 9c", Flush, "as ks 2s 3s 4s"},
+		// Four aces in hand are only a pair.
+		{"as ah ad ac", "kd 7c 2h 3s 9d", Pair, "as ah kd 7c 9d"},
+		// Trips on the board need a pair in hand for a full house.
+		{"kd kc 7c 8h", "ah ad ac 2s 3s", FullHouse, "kd kc ah ad ac"},
+		{"as kd 7c 8h", "ah ad ac 2s 3s", FourOfAKind, "as kd ah ad ac"},
+		{"as kd 7c 8h 9s", "ah ad ac 2s 3s", FourOfAKind, "as kd ah ad ac"},
+		{"2c 3c 7c 8h 9s 10d", "jd qd ks 4s 5c", Straight, "9s 10d jd qd ks"},
+		// Before the flop only the hole cards play.
+		{"as ad kc 2c", "", Pair, "as ad"},
+	}
+
+	for _, c := range cases {
+		hole, board := mustParseCards(t, c.Hole), mustParseCards(t, c.Board)
+		v, used := BestOmaha(hole, board)
+
+		if v.Class() != c.Class {
+			t.Errorf("%s | %s: expected: %v, got: %v", c.Hole, c.Board, c.Class, v)
+		}
+		if exp := mustParseCards(t, c.Used); !reflect.DeepEqual(used, exp) {
+			t.Errorf("%s | %s: expected: %v, got: %v", c.Hole, c.Board, exp, used)
+		}
+		if got := EvaluateOmaha(hole, board); got != v {
+			t.Errorf("%s | %s: expected: %v, got: %v", c.Hole, c.Board, v, got)
+		}
+	}
+}
+
+func TestEvaluateOmaha_Random(t *testing.T) {
+	t.Parallel()
+
+	// The used cards always make the value, and no choice of two hole and
+	// three board cards does better.
+	deck := NewDeck(rand.NewSource(18))
+	for n := 0; n < 3000; n++ {
+		for holeCards := 4; holeCards <= 6; holeCards++ {
+			deck.Reset()
+			deck.Shuffle()
+			hole, board := deck.Deal(holeCards), deck.Deal(5)
+
+			v, used := BestOmaha(hole, board)
+			if got := NewHandCards(used).Value(); got != v {
+				t.Fatalf("%v | %v: %v make %v, not %v", hole, board, used, got, v)
+			}
+
+			for i := 0; i < holeCards; i++ {
+				for j := i + 1; j < holeCards; j++ {
+					for a := 0; a < 5; a++ {
+						for b := a + 1; b < 5; b++ {
+							for c := b + 1; c < 5; c++ {
+								h := NewHandCards([]Card{hole[i], hole[j], board[a], board[b], board[c]})
+								if h.Value() > v {
+									t.Fatalf("%v | %v: %v beats %v", hole, board, h.Cards(), v)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestEvaluateOmahaMulti(t *testing.T) {
+	t.Parallel()
+
+	// Two aces of spades from two decks still make a flush with the board.
+	hole := mustParseCards(t, "as ks 2c 3d")
+	board := mustParseCards(t, "as qs js 7h 8c")
+	exp := NewMultiHand(mustParseCards(t, "as ks"), mustParseCards(t, "as qs js")).Value()
+	if got := EvaluateOmahaMulti(hole, board); got != exp || got.Class() != Flush {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+
+	// Without duplicates it scores like EvaluateOmaha.
+	deck := NewDeck(rand.NewSource(18))
+	for n := 0; n < 1000; n++ {
+		deck.Reset()
+		deck.Shuffle()
+		hole, board := deck.Deal(4), deck.Deal(n%6)
+
+		if exp, got := EvaluateOmaha(hole, board), EvaluateOmahaMulti(hole, board); got != exp {
+			t.Fatalf("%v | %v: expected: %v, got: %v", hole, board, exp, got)
+		}
+	}
+}
+
+func TestEvaluateOmahaEightOrBetter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Hole  string
+		Board string
+		Low   string
+	}{
+		{"as 2d kc kd", "3h 4s 8c kh qs", "8-4-3-2-A"},
+		{"as 2d 3c 4d", "5h 6s 7c kh qs", "7-6-5-2-A"},
+		// Only two hole cards may play, so the board needs three low cards.
+		{"as 2d 3c 4d", "5h 6s kc kh qs", ""},
+		{"kc kd qh qc", "3h 4s 5c 6h 7s", ""},
+		// Pairs do not qualify, even when the hand holds one.
+		{"as ad 2c 2h", "3h 4s 9c 9h 9s", ""},
+		{"as 2d 3c 4d", "5h 6s", ""},
+	}
+
+	for _, c := range cases {
+		v, ok := EvaluateOmahaEightOrBetter(mustParseCards(t, c.Hole), mustParseCards(t, c.Board))
+		if ok != (c.Low != "") || ok && v.String() != c.Low {
+			t.Errorf("%s | %s: expected: %q, got: %v %v", c.Hole, c.Board, c.Low, v, ok)
+		}
+	}
+}
+
+func benchmarkOmaha(b *testing.B, hole string) {
+	h, _ := ParseCards(hole)
+	board, _ := ParseCards("2c 7d 9h jc ks")
+	for i := 0; i < b.N; i++ {
+		EvaluateOmaha(h, board)
+	}
+}
+
+func BenchmarkOmaha_PLO4(b *testing.B) { benchmarkOmaha(b, "as ah kd qd") }
+func BenchmarkOmaha_PLO5(b *testing.B) { benchmarkOmaha(b, "as ah kd qd 8c") }
+func BenchmarkOmaha_PLO6(b *testing.B) { benchmarkOmaha(b, "as ah kd qd 8c 10h") }
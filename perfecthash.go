@@ -0,0 +1,171 @@
+package holdem
+
+import "sync"
+
+// Value7 scores a hand of exactly seven cards, giving the same value as
+// ValueCards(7) without branching on the kind of hand. It looks the value
+// up in perfect hash tables, built the first time it is called: one for
+// flushes by the ranks of the flush suit, and one for everything else by
+// how many cards of each rank there are. The value of a hand of any other
+// size is meaningless.
+//
+// Building with the holdem_perfecthash tag makes Value, Equity and
+// RangeEquity use it for seven card hands. Test that build with
+// go test -tags holdem_perfecthash, which also runs perfecthash_on_test.go.
+func (h Hand) Value7() HandValue {
+	hashOnce.Do(buildHashTables)
+	return h.hashValue()
+}
+
+// hashValue is Value7 once the tables are built.
+func (h Hand) hashValue() HandValue {
+	sc := uint32(h>>clubOffset) & 0x1FFF
+	sd := uint32(h>>diamondOffset) & 0x1FFF
+	sh := uint32(h>>heartOffset) & 0x1FFF
+	ss := uint32(h>>spadeOffset) & 0x1FFF
+
+	// Seven cards can't make a full house or quads next to a flush.
+	switch {
+	case nBitsTable[ss] >= 5:
+		return hashFlushes[ss]
+	case nBitsTable[sc] >= 5:
+		return hashFlushes[sc]
+	case nBitsTable[sd] >= 5:
+		return hashFlushes[sd]
+	case nBitsTable[sh] >= 5:
+		return hashFlushes[sh]
+	}
+
+	q := hashQuinary[sc] + hashQuinary[sd] + hashQuinary[sh] + hashQuinary[ss]
+	return hashValues[hashBase[q>>hashLowBits]+uint32(hashLowRank[q&hashLowMask])]
+}
+
+// EvaluateHoldemHash is like EvaluateHoldem, but scores seven cards with
+// Value7. Pass it to WithEvaluator to use it in a game.
+func EvaluateHoldemHash(hole, board []Card) HandValue {
+	h := NewHand(hole, board)
+	if len(hole)+len(board) == 7 {
+		return h.Value7()
+	}
+
+	return h.Value()
+}
+
+// value7 scores seven cards the way the build asks for.
+func value7(h Hand) HandValue {
+	if perfectHash {
+		return h.Value7()
+	}
+
+	return h.ValueCards(7)
+}
+
+// The rank counts of a hand are split into its six lowest ranks and its
+// seven highest, each written as a number in base 5. Adding up the counts
+// of each suit adds up these numbers without carrying, as no rank has more
+// than four cards.
+const (
+	hashLowRanks  = 6
+	hashLowBits   = 14
+	hashLowMask   = 1<<hashLowBits - 1
+	hashLowCounts = 15625 // 5^6
+	hashTopCounts = 78125 // 5^7
+	hashHands     = 49205 // Ways seven cards can fall into ranks
+)
+
+var (
+	hashOnce sync.Once
+
+	// hashQuinary has the base 5 numbers of the ranks in a suit, the low
+	// ranks in the bottom hashLowBits bits and the top ranks above them.
+	hashQuinary [8192]uint32
+
+	// hashLowRank orders the low rank counts with the same number of
+	// cards, and hashBase gives each top rank count the start of a block
+	// of hashValues, big enough for all the low counts that make seven
+	// cards with it.
+	hashLowRank [hashLowCounts]uint16
+	hashBase    [hashTopCounts]uint32
+
+	hashValues  []HandValue
+	hashFlushes [8192]HandValue
+)
+
+func buildHashTables() {
+	power := func(n int) uint32 {
+		p := uint32(1)
+		for ; n > 0; n-- {
+			p *= 5
+		}
+		return p
+	}
+
+	for mask := range hashQuinary {
+		var low, top uint32
+		for r := 0; r < 13; r++ {
+			if mask&(1<<r) == 0 {
+				continue
+			}
+			if r < hashLowRanks {
+				low += power(r)
+			} else {
+				top += power(r - hashLowRanks)
+			}
+		}
+		hashQuinary[mask] = top<<hashLowBits | low
+	}
+
+	// digits returns the counts of n, with the number of cards.
+	digits := func(n, ranks int) ([]int, int) {
+		counts := make([]int, ranks)
+		total := 0
+		for i := range counts {
+			counts[i] = n % 5
+			total += counts[i]
+			n /= 5
+		}
+		return counts, total
+	}
+
+	var lowBySize [8][]int
+	for n := 0; n < hashLowCounts; n++ {
+		if _, size := digits(n, hashLowRanks); size <= 7 {
+			hashLowRank[n] = uint16(len(lowBySize[size]))
+			lowBySize[size] = append(lowBySize[size], n)
+		}
+	}
+
+	// Padded so that hands of other sizes can't index past the end.
+	hashValues = make([]HandValue, hashHands+hashLowCounts)
+	var next uint32
+	for n := 0; n < hashTopCounts; n++ {
+		top, size := digits(n, 13-hashLowRanks)
+		if size > 7 {
+			continue
+		}
+
+		hashBase[n] = next
+		for _, l := range lowBySize[7-size] {
+			low, _ := digits(l, hashLowRanks)
+
+			// Deal the ranks out to the suits in turn, so none makes a flush.
+			var h Hand
+			suit := 0
+			for r, count := range append(low, top...) {
+				for ; count > 0; count-- {
+					h |= 1 << (r + 13*suit)
+					suit = (suit + 1) % 4
+				}
+			}
+
+			hashValues[next+uint32(hashLowRank[l])] = h.ValueCards(7)
+		}
+		next += uint32(len(lowBySize[7-size]))
+	}
+
+	for mask := range hashFlushes {
+		if n := int(nBitsTable[mask]); n >= 5 {
+			hashFlushes[mask] = Hand(mask).ValueCards(n)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+//go:build holdem_perfecthash
+
+package holdem
+
+// perfectHash makes seven card hands score with Value7.
+const perfectHash = true
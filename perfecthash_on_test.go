@@ -0,0 +1,59 @@
+//go:build holdem_perfecthash
+
+package holdem
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// Run these with go test -tags holdem_perfecthash.
+
+func TestPerfectHash_Value(t *testing.T) {
+	t.Parallel()
+
+	if !perfectHash {
+		t.Fatal("Expected the holdem_perfecthash tag to turn on Value7")
+	}
+
+	deck := NewDeck(rand.NewSource(24))
+	for n := 0; n < 200000; n++ {
+		size := 5 + n%3
+		deck.Reset()
+		deck.Shuffle()
+		h := NewHandCards(deck.Deal(size))
+
+		if exp, got := h.ValueCards(size), h.Value(); got != exp {
+			t.Fatalf("%v: expected: %v (%x), got: %v (%x)", h.Cards(), exp, exp, got, got)
+		}
+	}
+}
+
+func TestPerfectHash_Equity(t *testing.T) {
+	t.Parallel()
+
+	hole := [][]Card{mustParseCards(t, "as kd"), mustParseCards(t, "7c 7h")}
+	board := mustParseCards(t, "ad 7s 8h jd")
+	res, err := Equity(hole, board, nil, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Work out the same river by river with ValueCards.
+	var wins, rivers float64
+	a, b := NewHandCards(hole[0], board), NewHandCards(hole[1], board)
+	for c := Card(0); c < DeckSize; c++ {
+		if (a|b)&(1<<c) != 0 {
+			continue
+		}
+		rivers++
+		if (a | 1<<c).ValueCards(7) > (b | 1<<c).ValueCards(7) {
+			wins++
+		}
+	}
+
+	if exp := wins / rivers; !res.Exact || math.Abs(res.Players[0].Win-exp) > 1e-9 {
+		t.Errorf("Expected: %v, got: %v", exp, res.Players[0].Win)
+	}
+}
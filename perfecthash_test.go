@@ -0,0 +1,133 @@
+package holdem
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestHand_Value7(t *testing.T) {
+	t.Parallel()
+
+	cases := []string{
+		"as ks qs js 10s 2d 3c",
+		"ah 2h 3h 4h 5h 5d 5c",
+		"kc kd kh ks 2c 2d 3h",
+		"kc kd kh 2s 2c 2d 3h",
+		"ac jc 9c 4c 2c ad as",
+		"5c 6d 7h 8s 9c 2d 2h",
+		"ac 2d 3h 4s 5c kd kh",
+		"ac ad kh ks qc qd 2h",
+		"2c 3d 4h 5s 7c 8d 9h",
+	}
+	for _, c := range cases {
+		h := NewHandStr(c)
+		if exp, got := h.ValueCards(7), h.Value7(); got != exp {
+			t.Errorf("%s: expected: %v (%x), got: %v (%x)", c, exp, exp, got, got)
+		}
+	}
+
+	samples := 1000000
+	if testing.Short() {
+		samples = 50000
+	}
+
+	deck := NewDeck(rand.NewSource(24))
+	for n := 0; n < samples; n++ {
+		deck.Reset()
+		deck.Shuffle()
+		h := NewHandCards(deck.Deal(7))
+
+		if exp, got := h.ValueCards(7), h.Value7(); got != exp {
+			t.Fatalf("%v: expected: %v (%x), got: %v (%x)", h.Cards(), exp, exp, got, got)
+		}
+	}
+}
+
+func TestHand_Value7Tables(t *testing.T) {
+	t.Parallel()
+
+	hashOnce.Do(buildHashTables)
+
+	// Every way seven cards fall into ranks has its own slot.
+	seen := make(map[uint32]bool)
+	var counts [13]int
+	var walk func(r, left int)
+	walk = func(r, left int) {
+		if r == 13 {
+			if left > 0 {
+				return
+			}
+
+			var q uint32
+			for rank, n := range counts {
+				for i := 0; i < n; i++ {
+					q += hashQuinary[1<<rank]
+				}
+			}
+			slot := hashBase[q>>hashLowBits] + uint32(hashLowRank[q&hashLowMask])
+			if seen[slot] {
+				t.Fatalf("%v: slot %d is taken", counts, slot)
+			}
+			seen[slot] = true
+			return
+		}
+
+		for n := 0; n <= 4 && n <= left; n++ {
+			counts[r] = n
+			walk(r+1, left-n)
+		}
+		counts[r] = 0
+	}
+	walk(0, 7)
+
+	if len(seen) != hashHands {
+		t.Errorf("Expected: %d slots, got: %d", hashHands, len(seen))
+	}
+	for slot := range seen {
+		if slot >= hashHands {
+			t.Errorf("Slot %d is past the end", slot)
+		}
+	}
+}
+
+func TestEvaluateHoldemHash(t *testing.T) {
+	t.Parallel()
+
+	hole := mustParseCards(t, "ah kh")
+	for _, board := range []string{"", "qh jh 2c", "qh jh 2c 3d", "qh jh 2c 3d 10h"} {
+		cards := mustParseCards(t, board)
+		if exp, got := EvaluateHoldem(hole, cards), EvaluateHoldemHash(hole, cards); got != exp {
+			t.Errorf("%s: expected: %v, got: %v", board, exp, got)
+		}
+	}
+}
+
+// sevenCardHands deals n random seven card hands for the benchmarks.
+func sevenCardHands(n int) []Hand {
+	deck := NewDeck(rand.NewSource(1))
+	hands := make([]Hand, n)
+	for i := range hands {
+		deck.Reset()
+		deck.Shuffle()
+		hands[i] = NewHandCards(deck.Deal(7))
+	}
+
+	return hands
+}
+
+func BenchmarkValueCards7(b *testing.B) {
+	hands := sevenCardHands(1 << 12)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hands[i&(len(hands)-1)].ValueCards(7)
+	}
+}
+
+func BenchmarkValue7(b *testing.B) {
+	hands := sevenCardHands(1 << 12)
+	hands[0].Value7()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hands[i&(len(hands)-1)].Value7()
+	}
+}
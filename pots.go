@@ -0,0 +1,194 @@
+package holdem
+
+import "sort"
+
+// Evaluator scores a player's hole cards together with the board.
+type Evaluator func(hole, board []Card) HandValue
+
+// EvaluateHoldem scores the best five cards out of hole and board.
+func EvaluateHoldem(hole, board []Card) HandValue {
+	return NewHand(hole, board).Value()
+}
+
+// EvaluateMulti scores the best five cards out of hole and board, which may
+// contain duplicates when playing with several decks.
+func EvaluateMulti(hole, board []Card) HandValue {
+	return NewMultiHand(hole, board).Value()
+}
+
+// LowEvaluator scores a player's hole cards together with the board for the
+// low half of a split pot, and says whether the hand qualifies for it.
+type LowEvaluator func(hole, board []Card) (LowValue, bool)
+
+// EvaluateEightOrBetter scores the best ace-to-five low out of hole and
+// board, and whether it qualifies for an eight-or-better split pot.
+func EvaluateEightOrBetter(hole, board []Card) (LowValue, bool) {
+	return NewHand(hole, board).EightOrBetter()
+}
+
+// Half is the part of a pot an Award pays out.
+type Half int
+
+const (
+	WholePot Half = iota // No split, or no hand qualified for low
+	HighHalf
+	LowHalf
+)
+
+// Pot is the main pot or a side pot, and the players who can win it.
+type Pot struct {
+	Amount   uint32
+	Eligible []string
+}
+
+// Award is what a pot, or half of a split pot, paid out at the end of a
+// hand.
+type Award struct {
+	Pot     int      // Index into Pots; 0 is the main pot
+	Half    Half     // Which part of the pot this is
+	Amount  uint32   // Chips in this part of the pot
+	Winners []string // Players the chips were split between
+	Shares  []uint32 // Chips won by each of the winners
+	Hand    HandValue
+	Low     LowValue // The winning low, for the low half
+}
+
+// Pot returns the total number of chips bet this hand.
+func (g *Game) Pot() uint32 {
+	var total uint32
+	for _, p := range g.players {
+		total += p.Total
+	}
+
+	return total
+}
+
+// Pots splits the chips bet this hand into the main pot and side pots. A
+// new side pot starts at each all-in amount, and only the players who
+// covered that amount and have not folded can win it. Eligible players are
+// listed in seat order, starting after the button.
+func (g *Game) Pots() []Pot {
+	var levels []uint32
+	for _, p := range g.players {
+		if p.Status != Folded && p.Total > 0 {
+			levels = append(levels, p.Total)
+		}
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i] < levels[j] })
+
+	var pots []Pot
+	var prev uint32
+	for i, level := range levels {
+		if level == prev {
+			continue
+		}
+
+		var pot Pot
+		for _, p := range g.seatOrder() {
+			if i == len(levels)-1 {
+				// Chips folded above the last level stay in the last pot.
+				pot.Amount += p.Total - min32(p.Total, prev)
+			} else {
+				pot.Amount += min32(p.Total, level) - min32(p.Total, prev)
+			}
+
+			if p.Status != Folded && p.Total >= level {
+				pot.Eligible = append(pot.Eligible, p.Name)
+			}
+		}
+
+		pots = append(pots, pot)
+		prev = level
+	}
+
+	return pots
+}
+
+// Awards returns what each pot paid out at the end of the last hand.
+func (g *Game) Awards() []Award {
+	return g.awards
+}
+
+// showdown awards every pot to the best hands among its eligible players,
+// and credits their balances. A pot that does not split evenly gives its
+// odd chips one at a time to the winners in seat order, starting with the
+// first seat after the button.
+//
+// In split games each pot is halved between the best high and the best
+// qualifying low, and the odd chip goes to the high half. Without a
+// qualifying low the high hand takes the whole pot.
+func (g *Game) showdown() []Award {
+	values := make(map[string]HandValue)
+	lows := make(map[string]LowValue)
+	if g.inHand() > 1 {
+		for _, p := range g.players {
+			if p.Status == Folded {
+				continue
+			}
+
+			values[p.Name] = g.evaluate(p.Hand, g.community)
+			if g.evaluateLow != nil {
+				if v, ok := g.evaluateLow(p.Hand, g.community); ok {
+					lows[p.Name] = v
+				}
+			}
+		}
+	}
+
+	var awards []Award
+	for i, pot := range g.Pots() {
+		high := Award{Pot: i, Amount: pot.Amount}
+		for _, name := range pot.Eligible {
+			switch v := values[name]; {
+			case len(high.Winners) == 0 || v > high.Hand:
+				high.Winners = []string{name}
+				high.Hand = v
+			case v == high.Hand:
+				high.Winners = append(high.Winners, name)
+			}
+		}
+
+		low := Award{Pot: i, Half: LowHalf}
+		for _, name := range pot.Eligible {
+			v, ok := lows[name]
+			switch {
+			case !ok:
+			case len(low.Winners) == 0 || v < low.Low:
+				low.Winners = []string{name}
+				low.Low = v
+			case v == low.Low:
+				low.Winners = append(low.Winners, name)
+			}
+		}
+
+		if len(low.Winners) == 0 {
+			awards = append(awards, g.payOut(high))
+			continue
+		}
+
+		high.Half = HighHalf
+		high.Amount = (pot.Amount + 1) / 2
+		low.Amount = pot.Amount / 2
+		awards = append(awards, g.payOut(high), g.payOut(low))
+	}
+
+	return awards
+}
+
+// payOut splits an award between its winners and credits their balances.
+func (g *Game) payOut(a Award) Award {
+	share := a.Amount / uint32(len(a.Winners))
+	odd := a.Amount % uint32(len(a.Winners))
+	for k, name := range a.Winners {
+		won := share
+		if uint32(k) < odd {
+			won++
+		}
+
+		a.Shares = append(a.Shares, won)
+		p, _ := g.player(name)
+		p.Balance += won
+	}
+
+	return a
+}
@@ -0,0 +1,236 @@
+package holdem
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newShowdownGame(t *testing.T, board string, players ...string) *Game {
+	game := New()
+	for i := 0; i < len(players); i += 2 {
+		game.AddPlayer(players[i])
+		p, _ := game.player(players[i])
+		p.Status = Active
+		if players[i+1] == "" {
+			p.Status = Folded
+			continue
+		}
+
+		cards, err := ParseCards(players[i+1])
+		assert.NoError(t, err)
+		p.Hand = cards
+	}
+
+	cards, err := ParseCards(board)
+	assert.NoError(t, err)
+	game.community = cards
+
+	return &game
+}
+
+func bet(g *Game, name string, total uint32) {
+	p, _ := g.player(name)
+	p.Total = total
+	p.Balance -= total
+	if p.Balance == 0 {
+		p.Status = AllIn
+	}
+}
+
+func TestPots(t *testing.T) {
+	game := newShowdownGame(t, "", "A", "as ad", "B", "ks kd", "C", "qs qd", "D", "")
+	bet(game, "A", 20)
+	bet(game, "B", 50)
+	bet(game, "C", 50)
+	bet(game, "D", 10)
+
+	assert.Equal(t, []Pot{
+		{70, []string{"A", "B", "C"}},
+		{60, []string{"B", "C"}},
+	}, game.Pots())
+	assert.Equal(t, uint32(130), game.Pot())
+}
+
+func TestPots_Uncalled(t *testing.T) {
+	game := newShowdownGame(t, "", "A", "as ad", "B", "ks kd")
+	bet(game, "A", 100)
+	bet(game, "B", 40)
+
+	assert.Equal(t, []Pot{
+		{80, []string{"A", "B"}},
+		{60, []string{"A"}},
+	}, game.Pots())
+}
+
+func TestShowdown_SidePots(t *testing.T) {
+	game := newShowdownGame(t, "2c 7d 9h js 3s",
+		"A", "as ad", "B", "ks kd", "C", "qs qd", "D", "")
+	bet(game, "A", 20)
+	bet(game, "B", 50)
+	bet(game, "C", 50)
+	bet(game, "D", 10)
+
+	awards := game.showdown()
+	assert.Len(t, awards, 2)
+
+	assert.Equal(t, []string{"A"}, awards[0].Winners)
+	assert.Equal(t, []uint32{70}, awards[0].Shares)
+	assert.Equal(t, Pair, awards[0].Hand.Class())
+
+	assert.Equal(t, []string{"B"}, awards[1].Winners)
+	assert.Equal(t, []uint32{60}, awards[1].Shares)
+
+	a, _ := game.player("A")
+	b, _ := game.player("B")
+	c, _ := game.player("C")
+	assert.Equal(t, uint32(150), a.Balance)
+	assert.Equal(t, uint32(110), b.Balance)
+	assert.Equal(t, uint32(50), c.Balance)
+}
+
+func TestShowdown_Split(t *testing.T) {
+	game := newShowdownGame(t, "2c 3d 4h 5s 9c",
+		"A", "6h kd", "B", "", "C", "6s qd", "D", "6c jd")
+	bet(game, "A", 10)
+	bet(game, "B", 5)
+	bet(game, "C", 10)
+	bet(game, "D", 10)
+
+	awards := game.showdown()
+	assert.Len(t, awards, 1)
+	assert.Equal(t, uint32(35), awards[0].Amount)
+	assert.Equal(t, []string{"A", "C", "D"}, awards[0].Winners)
+	assert.Equal(t, []uint32{12, 12, 11}, awards[0].Shares)
+	assert.Equal(t, Straight, awards[0].Hand.Class())
+}
+
+func TestShowdown_Uncontested(t *testing.T) {
+	game := newShowdownGame(t, "", "A", "2c 7d", "B", "")
+	bet(game, "A", 30)
+	bet(game, "B", 10)
+
+	awards := game.showdown()
+	assert.Equal(t, []Award{{Pot: 0, Amount: 40, Winners: []string{"A"}, Shares: []uint32{40}}}, awards)
+}
+
+func newSplitGame(t *testing.T, board string, players ...string) *Game {
+	game := newShowdownGame(t, board, players...)
+	game.evaluateLow = EvaluateEightOrBetter
+
+	return game
+}
+
+func TestShowdown_HiLoScoop(t *testing.T) {
+	game := newSplitGame(t, "2c 3d 4h 9s kc", "A", "5d 6c", "B", "ks kd")
+	bet(game, "A", 21)
+	bet(game, "B", 21)
+
+	awards := game.showdown()
+	assert.Len(t, awards, 2)
+	assert.Equal(t, HighHalf, awards[0].Half)
+	assert.Equal(t, []string{"A"}, awards[0].Winners)
+	assert.Equal(t, []uint32{21}, awards[0].Shares)
+	assert.Equal(t, Straight, awards[0].Hand.Class())
+
+	assert.Equal(t, LowHalf, awards[1].Half)
+	assert.Equal(t, []string{"A"}, awards[1].Winners)
+	assert.Equal(t, []uint32{21}, awards[1].Shares)
+	assert.Equal(t, "6-5-4-3-2", awards[1].Low.String())
+
+	a, _ := game.player("A")
+	assert.Equal(t, uint32(121), a.Balance)
+}
+
+func TestShowdown_HiLoNoLow(t *testing.T) {
+	game := newSplitGame(t, "9c 10d jh 2s 3c", "A", "kd kc", "B", "4s 5h")
+	bet(game, "A", 20)
+	bet(game, "B", 20)
+
+	awards := game.showdown()
+	assert.Len(t, awards, 1)
+	assert.Equal(t, WholePot, awards[0].Half)
+	assert.Equal(t, []string{"A"}, awards[0].Winners)
+	assert.Equal(t, []uint32{40}, awards[0].Shares)
+}
+
+func TestShowdown_HiLoQuartered(t *testing.T) {
+	// A wins high with a flush and ties B for low, so A gets three
+	// quarters. The odd chip goes to the high half.
+	game := newSplitGame(t, "2d 3d 7d ks kc", "A", "ad 4d", "B", "ah 4c", "C", "")
+	bet(game, "A", 25)
+	bet(game, "B", 25)
+	bet(game, "C", 3)
+
+	awards := game.showdown()
+	assert.Len(t, awards, 2)
+	assert.Equal(t, HighHalf, awards[0].Half)
+	assert.Equal(t, uint32(27), awards[0].Amount)
+	assert.Equal(t, []string{"A"}, awards[0].Winners)
+	assert.Equal(t, Flush, awards[0].Hand.Class())
+
+	assert.Equal(t, LowHalf, awards[1].Half)
+	assert.Equal(t, uint32(26), awards[1].Amount)
+	assert.Equal(t, []string{"A", "B"}, awards[1].Winners)
+	assert.Equal(t, []uint32{13, 13}, awards[1].Shares)
+
+	a, _ := game.player("A")
+	b, _ := game.player("B")
+	assert.Equal(t, uint32(100-25+27+13), a.Balance)
+	assert.Equal(t, uint32(100-25+13), b.Balance)
+}
+
+func TestShowdown_HiLoSidePot(t *testing.T) {
+	// A is all in for the main pot only, and takes its low half. B and C
+	// split the high of the main pot, and the whole side pot goes to the
+	// best high since neither has a low.
+	game := newSplitGame(t, "2c 5d 8h ks kd", "A", "ac 3d", "B", "kc qs", "C", "kh qd")
+	game.players[1].Balance, game.players[2].Balance = 200, 200
+	bet(game, "A", 100)
+	bet(game, "B", 110)
+	bet(game, "C", 110)
+
+	awards := game.showdown()
+	assert.Len(t, awards, 3)
+	assert.Equal(t, Award{Pot: 0, Half: HighHalf, Amount: 150, Winners: []string{"B", "C"},
+		Shares: []uint32{75, 75}, Hand: awards[0].Hand}, awards[0])
+	assert.Equal(t, LowHalf, awards[1].Half)
+	assert.Equal(t, []string{"A"}, awards[1].Winners)
+	assert.Equal(t, uint32(150), awards[1].Amount)
+	assert.Equal(t, Award{Pot: 1, Half: WholePot, Amount: 20, Winners: []string{"B", "C"},
+		Shares: []uint32{10, 10}, Hand: awards[0].Hand}, awards[2])
+}
+
+func TestPlay_OmahaHiLo(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		game := New(WithOmahaHiLo(4), WithBlinds(1, 2), WithRandSource(rand.NewSource(seed)))
+		game.AddPlayer("A")
+		game.AddPlayer("B")
+		game.AddPlayer("C")
+
+		game.Play()
+
+		var total uint32
+		for _, p := range game.players {
+			total += p.Balance
+		}
+		assert.Equal(t, uint32(300), total)
+
+		for _, a := range game.Awards() {
+			var paid uint32
+			for _, s := range a.Shares {
+				paid += s
+			}
+			assert.Equal(t, a.Amount, paid)
+		}
+	}
+}
+
+func TestEvaluateMulti(t *testing.T) {
+	game := New(WithDecks(2))
+	hole, _ := ParseCards("as ah")
+	board := []Card{NewCardStr("as"), NewCardStr("ah"), NewCardStr("ac")}
+
+	assert.Equal(t, FiveOfAKind, game.evaluate(hole, board).Class())
+}
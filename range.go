@@ -0,0 +1,412 @@
+package holdem
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Errors returned by ParseRange, wrapped in a *RangeError.
+var (
+	ErrBadRange  = errors.New("bad range")
+	ErrBadWeight = errors.New("weight must be between 0 and 1")
+)
+
+// RangeError records a part of a range that could not be parsed and why.
+type RangeError struct {
+	Input string
+	Err   error
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("holdem: parsing range %q: %v", e.Input, e.Err)
+}
+
+// Unwrap returns the underlying error, so errors.Is(err, ErrBadRange) works.
+func (e *RangeError) Unwrap() error {
+	return e.Err
+}
+
+// Combo is a pair of hole cards, the higher card first. Cards of the same
+// rank are ordered by suit, spades first.
+type Combo [2]Card
+
+// NewCombo makes a combo of two cards in either order.
+func NewCombo(a, b Card) Combo {
+	if comboLess(a, b) {
+		a, b = b, a
+	}
+
+	return Combo{a, b}
+}
+
+// Hand returns the combo as a Hand.
+func (c Combo) Hand() Hand {
+	return 1<<c[0] | 1<<c[1]
+}
+
+func (c Combo) String() string {
+	return fmt.Sprintf("%s%s", rangeCard(c[0]), rangeCard(c[1]))
+}
+
+// WeightedCombo is a combo in a range, and how often it is played.
+type WeightedCombo struct {
+	Combo
+	Weight float64
+}
+
+// Range is a set of hole cards an opponent might hold, each with a weight
+// between 0 and 1. The zero Range is empty.
+type Range struct {
+	weights map[Combo]float64
+}
+
+// ParseRange parses a range in the usual notation: a list of hands
+// separated by commas or whitespace. A hand is a pair like "QQ", two ranks
+// with "s" for suited or "o" for offsuit like "AKs", two ranks alone for
+// both, or two exact cards like "AsKs". "QQ+" adds every higher pair and
+// "A5s+" every higher kicker, up to AKs; "JJ-88" and "A5s-A2s" add
+// everything in between. A ":0.5" suffix plays a hand half of the time.
+// Later hands override the weights of earlier ones.
+func ParseRange(str string) (Range, error) {
+	var r Range
+
+	fields := strings.FieldsFunc(str, func(c rune) bool {
+		return c == ',' || unicode.IsSpace(c)
+	})
+	for _, f := range fields {
+		weight := 1.0
+		hand := f
+		if i := strings.IndexByte(f, ':'); i >= 0 {
+			w, err := strconv.ParseFloat(f[i+1:], 64)
+			if err != nil {
+				return Range{}, &RangeError{f, ErrBadWeight}
+			}
+			if !(w >= 0 && w <= 1) {
+				return Range{}, &RangeError{f, ErrBadWeight}
+			}
+			hand, weight = f[:i], w
+		}
+
+		combos, err := parseRangeHand(hand)
+		if err != nil {
+			return Range{}, err
+		}
+		for _, c := range combos {
+			r.Set(c, weight)
+		}
+	}
+
+	return r, nil
+}
+
+// MustParseRange is like ParseRange, but panics if the range does not parse.
+func MustParseRange(str string) Range {
+	r, err := ParseRange(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Set changes the weight of a combo. A weight of zero removes it.
+func (r *Range) Set(c Combo, weight float64) {
+	c = NewCombo(c[0], c[1])
+	if weight <= 0 {
+		delete(r.weights, c)
+		return
+	}
+
+	if r.weights == nil {
+		r.weights = make(map[Combo]float64)
+	}
+	r.weights[c] = weight
+}
+
+// Weight returns how often the range plays a combo, 0 if it never does.
+func (r Range) Weight(c Combo) float64 {
+	return r.weights[NewCombo(c[0], c[1])]
+}
+
+// Len returns the number of combos in the range.
+func (r Range) Len() int {
+	return len(r.weights)
+}
+
+// Combos returns every combo in the range, the best ranks first.
+func (r Range) Combos() []WeightedCombo {
+	combos := make([]WeightedCombo, 0, len(r.weights))
+	for c, w := range r.weights {
+		combos = append(combos, WeightedCombo{c, w})
+	}
+	sort.Slice(combos, func(i, j int) bool {
+		a, b := combos[i].Combo, combos[j].Combo
+		if a[0] != b[0] {
+			return comboLess(b[0], a[0])
+		}
+		return comboLess(b[1], a[1])
+	})
+
+	return combos
+}
+
+// Without returns a copy of the range without the combos that use any of
+// cards, such as the board or a player's own hole cards.
+func (r Range) Without(cards ...Card) Range {
+	var blocked Hand
+	for _, c := range cards {
+		blocked |= 1 << c
+	}
+
+	var out Range
+	for c, w := range r.weights {
+		if c.Hand()&blocked == 0 {
+			out.Set(c, w)
+		}
+	}
+
+	return out
+}
+
+// String returns the range in compact, canonical form: pairs, then suited
+// and then offsuit hands, the best first, with runs of hands of the same
+// weight joined up. Hands only partly in the range are listed combo by
+// combo at the end.
+func (r Range) String() string {
+	var parts, exact []string
+
+	// Pairs, from aces down.
+	var run []int
+	var runWeight float64
+	flush := func(suited string) {
+		if len(run) == 0 {
+			return
+		}
+
+		parts = append(parts, rangeRun(run, suited)+rangeWeight(runWeight))
+		run = nil
+	}
+	add := func(rank int, weight float64, suited string) {
+		if len(run) > 0 && (weight != runWeight || run[len(run)-1] != rank+1) {
+			flush(suited)
+		}
+		run = append(run, rank)
+		runWeight = weight
+	}
+
+	for rank := 12; rank >= 0; rank-- {
+		w, whole := r.classWeight(rangeClass(rank, rank, false), &exact)
+		if whole {
+			add(rank, w, "")
+		} else {
+			flush("")
+		}
+	}
+	flush("")
+
+	for _, suited := range []bool{true, false} {
+		suffix := "o"
+		if suited {
+			suffix = "s"
+		}
+
+		for high := 12; high > 0; high-- {
+			for low := high - 1; low >= 0; low-- {
+				w, whole := r.classWeight(rangeClass(high, low, suited), &exact)
+				if whole {
+					add(high*13+low, w, suffix)
+				} else {
+					flush(suffix)
+				}
+			}
+			flush(suffix)
+		}
+	}
+
+	return strings.Join(append(parts, exact...), ", ")
+}
+
+// classWeight returns the weight of every combo of a hand like "AKs" if
+// they all share one; otherwise it appends those in the range to exact.
+func (r Range) classWeight(class []Combo, exact *[]string) (float64, bool) {
+	w := r.weights[class[0]]
+	whole := w > 0
+	for _, c := range class[1:] {
+		if r.weights[c] != w {
+			whole = false
+		}
+	}
+	if whole {
+		return w, true
+	}
+
+	for _, c := range class {
+		if cw, ok := r.weights[c]; ok {
+			*exact = append(*exact, c.String()+rangeWeight(cw))
+		}
+	}
+
+	return 0, false
+}
+
+// rangeRun formats a run of pairs, or of hands with the same high card,
+// given from the best down. Ranks of non-pairs are high*13 + low.
+func rangeRun(run []int, suffix string) string {
+	name := func(v int) string {
+		if suffix == "" {
+			return rangeRanks[v:v+1] + rangeRanks[v:v+1]
+		}
+		return rangeRanks[v/13:v/13+1] + rangeRanks[v%13:v%13+1] + suffix
+	}
+
+	top, bottom := run[0], run[len(run)-1]
+	switch {
+	case len(run) == 1:
+		return name(top)
+	case suffix == "" && top == 12, suffix != "" && top%13 == top/13-1:
+		return name(bottom) + "+"
+	}
+
+	return name(top) + "-" + name(bottom)
+}
+
+func rangeWeight(w float64) string {
+	if w == 1 {
+		return ""
+	}
+
+	return ":" + strconv.FormatFloat(w, 'g', -1, 64)
+}
+
+// rangeRanks are the ranks as range notation writes them, by Card.Value.
+const rangeRanks = "23456789TJQKA"
+
+func rangeCard(c Card) string {
+	return rangeRanks[c.Value():c.Value()+1] + "cdhs"[c.Suit():c.Suit()+1]
+}
+
+// rangeClass returns every combo of a pair, or of two ranks suited or
+// offsuit.
+func rangeClass(high, low int, suited bool) []Combo {
+	var combos []Combo
+	for s1 := Spades; s1 >= Clubs; s1-- {
+		for s2 := Spades; s2 >= Clubs; s2-- {
+			a, b := Card(high+13*s1), Card(low+13*s2)
+			switch {
+			case high == low && s2 >= s1:
+			case high != low && suited != (s1 == s2):
+			default:
+				combos = append(combos, NewCombo(a, b))
+			}
+		}
+	}
+
+	return combos
+}
+
+// parseRangeHand expands one hand of a range, without its weight.
+func parseRangeHand(hand string) ([]Combo, error) {
+	if len(hand) == 4 {
+		if a, err := ParseCard(hand[:2]); err == nil {
+			b, err := ParseCard(hand[2:])
+			if err != nil {
+				return nil, err
+			}
+			if a == b {
+				return nil, &ParseError{hand, ErrDuplicate}
+			}
+			return []Combo{NewCombo(a, b)}, nil
+		}
+	}
+
+	var from, to string
+	plus := false
+	switch i := strings.IndexByte(hand, '-'); {
+	case i >= 0:
+		from, to = hand[:i], hand[i+1:]
+	case strings.HasSuffix(hand, "+"):
+		from, plus = hand[:len(hand)-1], true
+	default:
+		from, to = hand, hand
+	}
+
+	high, low, suits, ok := parseRangeClass(from)
+	if !ok {
+		return nil, &RangeError{hand, ErrBadRange}
+	}
+
+	// Work out the lowest and highest low card, for pairs both cards.
+	lo, hi := low, low
+	switch {
+	case plus && high == low:
+		hi = 12
+	case plus:
+		hi = high - 1
+	default:
+		h2, l2, s2, ok := parseRangeClass(to)
+		if !ok || s2 != suits || (high == low) != (h2 == l2) || (high != low && h2 != high) {
+			return nil, &RangeError{hand, ErrBadRange}
+		}
+		if l2 < lo {
+			lo = l2
+		} else {
+			hi = l2
+		}
+	}
+
+	var combos []Combo
+	for l := lo; l <= hi; l++ {
+		h := high
+		if high == low {
+			h = l
+		}
+
+		if suits != "o" && h != l {
+			combos = append(combos, rangeClass(h, l, true)...)
+		}
+		if suits != "s" || h == l {
+			combos = append(combos, rangeClass(h, l, false)...)
+		}
+	}
+
+	return combos, nil
+}
+
+// parseRangeClass parses a hand like "QQ", "AK", "AKs" or "AKo". Suits is
+// "s", "o" or empty for both. Pairs cannot be suited.
+func parseRangeClass(s string) (high, low int, suits string, ok bool) {
+	if len(s) < 2 || len(s) > 3 {
+		return 0, 0, "", false
+	}
+
+	high = strings.IndexByte(rangeRanks, byte(unicode.ToUpper(rune(s[0]))))
+	low = strings.IndexByte(rangeRanks, byte(unicode.ToUpper(rune(s[1]))))
+	if high < 0 || low < 0 {
+		return 0, 0, "", false
+	}
+	if high < low {
+		high, low = low, high
+	}
+
+	if len(s) == 3 {
+		suits = strings.ToLower(s[2:])
+		if (suits != "s" && suits != "o") || high == low {
+			return 0, 0, "", false
+		}
+	}
+
+	return high, low, suits, true
+}
+
+// comboLess orders cards by rank, then suit.
+func comboLess(a, b Card) bool {
+	if a.Value() != b.Value() {
+		return a.Value() < b.Value()
+	}
+
+	return a.Suit() < b.Suit()
+}
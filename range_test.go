@@ -0,0 +1,115 @@
+package holdem
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Range  string
+		Combos int
+		String string
+	}{
+		{"QQ+", 18, "QQ+"},
+		{"AKs", 4, "AKs"},
+		{"AKo", 12, "AKo"},
+		{"AK", 16, "AKs, AKo"},
+		{"KA", 16, "AKs, AKo"},
+		{"A5s-A2s", 16, "A5s-A2s"},
+		{"A2s-A5s", 16, "A5s-A2s"},
+		{"JJ-88", 24, "JJ-88"},
+		{"KTs+", 12, "KTs+"},
+		{"22+", 78, "22+"},
+		{"QQ+, AKs, A5s-A2s, KQo, 76s:0.5", 18 + 4 + 16 + 12 + 4, "QQ+, AKs, A5s-A2s, 76s:0.5, KQo"},
+		{"AsKs, 7h6h:0.25", 2, "AsKs, 7h6h:0.25"},
+		{"aks,kk qq", 16, "KK-QQ, AKs"},
+		{"AA, AsAh:0.5", 6, "AsAh:0.5, AsAd, AsAc, AhAd, AhAc, AdAc"},
+		{"AKs, AKs:0", 0, ""},
+		{"", 0, ""},
+		{"99, 77, AQs, AJs, A9s", 24, "99, 77, AQs-AJs, A9s"},
+	}
+
+	for _, c := range cases {
+		r, err := ParseRange(c.Range)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.Range, err)
+			continue
+		}
+
+		if got := r.Len(); got != c.Combos {
+			t.Errorf("%s: expected: %d combos, got: %d", c.Range, c.Combos, got)
+		}
+		if got := r.String(); got != c.String {
+			t.Errorf(`%s: expected: "%s", got: "%s"`, c.Range, c.String, got)
+		}
+
+		again, err := ParseRange(r.String())
+		if err != nil || again.String() != r.String() || again.Len() != r.Len() {
+			t.Errorf("%s: %s does not parse back to itself", c.Range, r)
+		}
+	}
+}
+
+func TestParseRange_Errors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Range  string
+		Expect error
+	}{
+		{"AKx", ErrBadRange},
+		{"AAs", ErrBadRange},
+		{"ZZ", ErrBadRange},
+		{"AKs-KQs", ErrBadRange},
+		{"JJ-A2s", ErrBadRange},
+		{"AKs-A2o", ErrBadRange},
+		{"AKs:2", ErrBadWeight},
+		{"AKs:x", ErrBadWeight},
+		{"AKs:NaN", ErrBadWeight},
+		{"AsAs", ErrDuplicate},
+		{"AsKx", ErrBadSuit},
+	}
+
+	for _, c := range cases {
+		if _, err := ParseRange(c.Range); !errors.Is(err, c.Expect) {
+			t.Errorf("%s: expected: %v, got: %v", c.Range, c.Expect, err)
+		}
+	}
+}
+
+func TestRange_Weights(t *testing.T) {
+	t.Parallel()
+
+	r := MustParseRange("QQ+, 76s:0.5")
+	as, ah, kd := NewCardStr("as"), NewCardStr("ah"), NewCardStr("kd")
+
+	if got := r.Weight(NewCombo(ah, as)); got != 1 {
+		t.Errorf("Expected: 1, got: %v", got)
+	}
+	if got := r.Weight(NewCombo(NewCardStr("7c"), NewCardStr("6c"))); got != 0.5 {
+		t.Errorf("Expected: 0.5, got: %v", got)
+	}
+	if got := r.Weight(NewCombo(as, kd)); got != 0 {
+		t.Errorf("Expected: 0, got: %v", got)
+	}
+
+	combos := r.Combos()
+	if first := combos[0].Combo; first != (Combo{as, ah}) {
+		t.Errorf("Expected: AsAh first, got: %v", first)
+	}
+
+	// Blocking the ace of spades takes out three combos of aces.
+	blocked := r.Without(as, NewCardStr("7c"))
+	if exp, got := 18+4-3-1, blocked.Len(); exp != got {
+		t.Errorf("Expected: %d, got: %d", exp, got)
+	}
+	if exp, got := "KK-QQ, AhAd, AhAc, AdAc, 7s6s:0.5, 7h6h:0.5, 7d6d:0.5", blocked.String(); exp != got {
+		t.Errorf(`Expected: "%s", got: "%s"`, exp, got)
+	}
+	if r.Len() != 22 {
+		t.Error("Expected Without to leave the range alone")
+	}
+}
@@ -0,0 +1,386 @@
+package holdem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// ErrEmptyRange is returned by RangeEquity when a range has no combos left
+// once the board, the dead cards and the other ranges are taken out.
+var ErrEmptyRange = errors.New("holdem: range has no combos left to deal")
+
+// ComboEquity is how one combo of a range fares.
+type ComboEquity struct {
+	Combo  Combo
+	Weight float64 // Weight of the combo in the range
+	Freq   float64 // How often the player holds it, after card removal
+	PlayerEquity
+}
+
+// RangeEquityResult is the outcome of a range equity calculation.
+type RangeEquityResult struct {
+	Players []PlayerEquity  // In the order the ranges were given
+	Combos  [][]ComboEquity // Every combo each player can hold, best first
+	Exact   bool            // Every deal was enumerated, rather than sampled
+	Runouts int             // Deals of hole cards and board enumerated or sampled
+}
+
+// RangeEquity works out the chances of each range against the others. A
+// deal gives every player a combo from their range, so that no card is used
+// twice, and then completes the board; deals are as likely as the weights
+// of their combos multiplied together. Like Equity, it enumerates every
+// deal when there are few enough, and samples them otherwise, in which
+// case samples must be positive. For a single
+// hand against a range, give the hand as a range of one combo.
+//
+// The calculation stops early with ctx's error once ctx is done.
+func RangeEquity(ctx context.Context, ranges []Range, board, dead []Card, samples int, opts ...EquityOption) (RangeEquityResult, error) {
+	c := equityConfig{workers: runtime.NumCPU(), exactLimit: DefaultExactLimit}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.workers < 1 {
+		c.workers = 1
+	}
+
+	if len(ranges) < 2 {
+		return RangeEquityResult{}, ErrTooFewPlayers
+	}
+	if len(board) > 5 {
+		return RangeEquityResult{}, ErrBoardSize
+	}
+
+	var known Hand
+	for _, cards := range [][]Card{board, dead} {
+		for _, card := range cards {
+			if known&(1<<card) != 0 {
+				return RangeEquityResult{}, fmt.Errorf("%w: %v", ErrDuplicate, card)
+			}
+			known |= 1 << card
+		}
+	}
+
+	j := newRangeJob(ranges, board, dead)
+	if len(j.deck) < 2*len(ranges)+j.need {
+		return RangeEquityResult{}, fmt.Errorf("%w: not enough cards left to deal", ErrMissingCard)
+	}
+
+	boards := binomial(len(j.deck)-2*len(ranges), j.need)
+	limit := c.exactLimit
+	if samples > limit {
+		limit = samples
+	}
+
+	// Only count deals as far as it takes to tell whether to enumerate.
+	most := 0
+	if c.exactLimit > 0 {
+		most = limit / boards
+	}
+	deals := j.countDeals(ctx, most)
+	if err := ctx.Err(); err != nil {
+		return RangeEquityResult{}, err
+	}
+	if deals == 0 {
+		return RangeEquityResult{}, ErrEmptyRange
+	}
+
+	var t rangeTally
+	var err error
+	exact := c.exactLimit > 0 && deals <= most
+	switch {
+	case exact:
+		t, err = j.enumerate(ctx, c.workers)
+	case samples <= 0:
+		return RangeEquityResult{}, fmt.Errorf("%w: %d", ErrSamples, samples)
+	default:
+		t, err = j.sample(ctx, samples, c)
+	}
+	if err != nil {
+		return RangeEquityResult{}, err
+	}
+
+	return j.result(t, exact), nil
+}
+
+// rangeJob is a range equity calculation, once the ranges have had the
+// board and dead cards taken out.
+type rangeJob struct {
+	combos  [][]WeightedCombo
+	board   Hand
+	deck    []Card // Cards not on the board or dead
+	need    int    // Board cards left to deal
+	players int
+}
+
+func newRangeJob(ranges []Range, board, dead []Card) *rangeJob {
+	blocked := append(append([]Card{}, board...), dead...)
+
+	j := &rangeJob{
+		board:   NewHandCards(board),
+		need:    5 - len(board),
+		players: len(ranges),
+	}
+	for _, r := range ranges {
+		j.combos = append(j.combos, r.Without(blocked...).Combos())
+	}
+
+	used := NewHandCards(blocked)
+	for c := Card(0); c < DeckSize; c++ {
+		if used&(1<<c) == 0 {
+			j.deck = append(j.deck, c)
+		}
+	}
+
+	return j
+}
+
+// countDeals returns the number of ways to give every player a combo, or
+// any number above most once there are more than that.
+func (j *rangeJob) countDeals(ctx context.Context, most int) int {
+	var count func(p int, used Hand) int
+	count = func(p int, used Hand) int {
+		if p == j.players {
+			return 1
+		}
+
+		n := 0
+		for _, c := range j.combos[p] {
+			if h := c.Hand(); h&used == 0 {
+				n += count(p+1, used|h)
+			}
+			if n > most || ctx.Err() != nil {
+				return n
+			}
+		}
+
+		return n
+	}
+
+	return count(0, 0)
+}
+
+// rangeTally adds up the results of every combo of every player.
+type rangeTally struct {
+	runouts int
+	combos  [][]equityStats
+	values  []HandValue
+	hands   []Hand
+}
+
+func (j *rangeJob) newTally() rangeTally {
+	t := rangeTally{
+		combos: make([][]equityStats, j.players),
+		values: make([]HandValue, j.players),
+		hands:  make([]Hand, j.players),
+	}
+	for p := range t.combos {
+		t.combos[p] = make([]equityStats, len(j.combos[p]))
+	}
+
+	return t
+}
+
+// add scores a complete board for the combos picked for every player.
+func (t *rangeTally) add(picks []int, board Hand, weight float64) {
+	best, winners := showdownValues(t.hands, board, t.values)
+	for p, v := range t.values {
+		t.combos[p][picks[p]].record(weight, v == best, winners)
+	}
+	t.runouts++
+}
+
+func (t *rangeTally) merge(o rangeTally) {
+	t.runouts += o.runouts
+	for p := range t.combos {
+		for i := range t.combos[p] {
+			t.combos[p][i].merge(o.combos[p][i])
+		}
+	}
+}
+
+// enumerate deals every combo of every player with every board. The deals
+// are split up by the first player's combo, so workers can share them out.
+func (j *rangeJob) enumerate(ctx context.Context, workers int) (rangeTally, error) {
+	tallies := make([]rangeTally, len(j.combos[0]))
+	next := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(tallies); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			picks := make([]int, j.players)
+			left := make([]Card, 0, len(j.deck))
+			for i := range next {
+				t := j.newTally()
+
+				var walk func(p int, used Hand, weight float64)
+				walk = func(p int, used Hand, weight float64) {
+					if p == j.players {
+						left = left[:0]
+						for _, c := range j.deck {
+							if used&(1<<c) == 0 {
+								left = append(left, c)
+							}
+						}
+						enumerateBoards(left, j.need, j.board, func(board Hand) {
+							t.add(picks, board, weight)
+						})
+						return
+					}
+
+					for k, c := range j.combos[p] {
+						if h := c.Hand(); h&used == 0 {
+							picks[p] = k
+							t.hands[p] = h
+							walk(p+1, used|h, weight*c.Weight)
+						}
+					}
+				}
+
+				if ctx.Err() == nil {
+					c := j.combos[0][i]
+					picks[0] = i
+					t.hands[0] = c.Hand()
+					walk(1, c.Hand(), c.Weight)
+				}
+				tallies[i] = t
+			}
+		}()
+	}
+
+	for i := range tallies {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return rangeTally{}, err
+	}
+
+	return j.mergeTallies(tallies), nil
+}
+
+// sample deals samples random combos and boards, in chunks with seeds of
+// their own like Equity.
+func (j *rangeJob) sample(ctx context.Context, samples int, c equityConfig) (rangeTally, error) {
+	// Cumulative weights, to pick combos in proportion to them.
+	cumulative := make([][]float64, j.players)
+	for p, combos := range j.combos {
+		var total float64
+		for _, c := range combos {
+			total += c.Weight
+			cumulative[p] = append(cumulative[p], total)
+		}
+	}
+
+	tallies := make([]rangeTally, numChunks(samples))
+	sampleChunks(samples, c, func() func(i, n int, r *rand.Rand) {
+		picks := make([]int, j.players)
+		left := make([]Card, 0, len(j.deck))
+		return func(i, n int, r *rand.Rand) {
+			t := j.newTally()
+			for ; n > 0; n-- {
+				used, ok := j.pick(ctx, r, cumulative, picks, t.hands)
+				if !ok {
+					break
+				}
+
+				left = left[:0]
+				for _, c := range j.deck {
+					if used&(1<<c) == 0 {
+						left = append(left, c)
+					}
+				}
+				t.add(picks, j.board|dealRandom(r, left, j.need), 1)
+			}
+			tallies[i] = t
+		}
+	})
+
+	if err := ctx.Err(); err != nil {
+		return rangeTally{}, err
+	}
+
+	return j.mergeTallies(tallies), nil
+}
+
+// pick gives every player a random combo, in proportion to its weight. When
+// a combo clashes with one already given out, it starts over, so that every
+// deal stays as likely as its weight. It returns false once ctx is done.
+func (j *rangeJob) pick(ctx context.Context, r *rand.Rand, cumulative [][]float64, picks []int, hands []Hand) (Hand, bool) {
+	for ctx.Err() == nil {
+		var used Hand
+		p := 0
+		for ; p < j.players; p++ {
+			weights := cumulative[p]
+			k := sort.SearchFloat64s(weights, r.Float64()*weights[len(weights)-1])
+			if k == len(weights) {
+				k--
+			}
+
+			h := j.combos[p][k].Hand()
+			if h&used != 0 {
+				break
+			}
+			picks[p] = k
+			hands[p] = h
+			used |= h
+		}
+
+		if p == j.players {
+			return used, true
+		}
+	}
+
+	return 0, false
+}
+
+// mergeTallies adds up tallies in order, like mergeTallies for Equity.
+func (j *rangeJob) mergeTallies(tallies []rangeTally) rangeTally {
+	total := j.newTally()
+	for _, t := range tallies {
+		total.merge(t)
+	}
+
+	return total
+}
+
+func (j *rangeJob) result(t rangeTally, exact bool) RangeEquityResult {
+	res := RangeEquityResult{
+		Players: make([]PlayerEquity, j.players),
+		Combos:  make([][]ComboEquity, j.players),
+		Exact:   exact,
+		Runouts: t.runouts,
+	}
+
+	for p, stats := range t.combos {
+		var total equityStats
+		for _, s := range stats {
+			total.merge(s)
+		}
+		res.Players[p] = total.equity()
+
+		for i, s := range stats {
+			if s.weight == 0 {
+				continue
+			}
+
+			res.Combos[p] = append(res.Combos[p], ComboEquity{
+				Combo:        j.combos[p][i].Combo,
+				Weight:       j.combos[p][i].Weight,
+				Freq:         s.weight / total.weight,
+				PlayerEquity: s.equity(),
+			})
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,211 @@
+package holdem
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRangeEquity(t *testing.T) {
+	t.Parallel()
+
+	// A single combo against a single combo is the same as Equity.
+	board := mustParseCards(t, "2d 7s qd")
+	hole := [][]Card{mustParseCards(t, "as kd"), mustParseCards(t, "jd 10d")}
+	exp, _ := Equity(hole, board, nil, 0)
+
+	ranges := []Range{MustParseRange("AsKd"), MustParseRange("JdTd")}
+	res, err := RangeEquity(context.Background(), ranges, board, nil, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !res.Exact || res.Runouts != exp.Runouts {
+		t.Errorf("Expected every board to be dealt, got: %+v", res)
+	}
+	for p := range exp.Players {
+		if !equityClose(res.Players[p], exp.Players[p], 1e-9) {
+			t.Errorf("Player %d: expected: %v, got: %v", p, exp.Players[p], res.Players[p])
+		}
+	}
+}
+
+func TestRangeEquity_CardRemoval(t *testing.T) {
+	t.Parallel()
+
+	// The board and the hero's hand leave villain three combos of aces and
+	// one combo of kings.
+	board := mustParseCards(t, "ks 7d 2c")
+	ranges := []Range{MustParseRange("AhKh"), MustParseRange("AA, KK")}
+	res, err := RangeEquity(context.Background(), ranges, board, nil, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	combos := res.Combos[1]
+	if len(combos) != 4 {
+		t.Fatalf("Expected 4 combos, got: %v", combos)
+	}
+	for _, c := range combos {
+		if math.Abs(c.Freq-0.25) > 1e-9 {
+			t.Errorf("%v: expected: 0.25 of deals, got: %v", c.Combo, c.Freq)
+		}
+	}
+
+	if exp := (Combo{NewCardStr("kd"), NewCardStr("kc")}); combos[3].Combo != exp {
+		t.Errorf("Expected: %v, got: %v", exp, combos[3].Combo)
+	}
+	if combos[3].Share < 0.9 {
+		t.Errorf("Expected a set of kings to be far ahead, got: %v", combos[3].Share)
+	}
+
+	// The hand's equity is the weighted sum of the combos' equities.
+	var share float64
+	for _, c := range combos {
+		share += c.Freq * c.Share
+	}
+	if math.Abs(share-res.Players[1].Share) > 1e-9 {
+		t.Errorf("Expected: %v, got: %v", res.Players[1].Share, share)
+	}
+	if sum := res.Players[0].Share + res.Players[1].Share; math.Abs(sum-1) > 1e-9 {
+		t.Errorf("Shares add up to %v", sum)
+	}
+}
+
+func TestRangeEquity_Weights(t *testing.T) {
+	t.Parallel()
+
+	// Halving the weight of the kings leaves them a third of the deals.
+	board := mustParseCards(t, "2c 3d 4h 8s 9c")
+	ranges := []Range{MustParseRange("QQ"), MustParseRange("AA, KK:0.5")}
+	res, err := RangeEquity(context.Background(), ranges, board, nil, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if exp := 2.0 / 3; math.Abs(res.Players[1].Win-1) > 1e-9 || math.Abs(res.Combos[1][0].Freq*6-exp) > 1e-9 {
+		t.Errorf("Expected aces to be dealt %v of the time, got: %v", exp, res.Combos[1][0].Freq*6)
+	}
+}
+
+func TestRangeEquity_Sampled(t *testing.T) {
+	t.Parallel()
+
+	ranges := []Range{MustParseRange("TT+, AQs+"), MustParseRange("22+, A2s+, KTo+")}
+	exact, err := RangeEquity(context.Background(), ranges, mustParseCards(t, "ah 7c 2s 9d"), nil, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !exact.Exact {
+		t.Fatal("Expected the turn to be enumerated")
+	}
+
+	sampled, err := RangeEquity(context.Background(), ranges, mustParseCards(t, "ah 7c 2s 9d"), nil, 50000,
+		WithExactLimit(0), WithEquitySeed(3))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if sampled.Exact || sampled.Runouts != 50000 {
+		t.Errorf("Expected 50000 samples, got: %v", sampled.Runouts)
+	}
+	if !equityClose(exact.Players[0], sampled.Players[0], 0.01) {
+		t.Errorf("Expected sampling to agree with enumeration: %v, %v", exact.Players[0], sampled.Players[0])
+	}
+
+	again, _ := RangeEquity(context.Background(), ranges, mustParseCards(t, "ah 7c 2s 9d"), nil, 50000,
+		WithExactLimit(0), WithEquitySeed(3), WithWorkers(1))
+	if !reflect.DeepEqual(sampled, again) {
+		t.Error("Expected the same seed to give the same result")
+	}
+}
+
+func TestRangeEquity_Cancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ranges := []Range{MustParseRange("22+"), MustParseRange("22+")}
+	if _, err := RangeEquity(ctx, ranges, nil, nil, 1000000); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected: %v, got: %v", context.Canceled, err)
+	}
+
+	// Combos that always clash are retried until ctx is done.
+	j := newRangeJob([]Range{MustParseRange("AsAh"), MustParseRange("AsAd")}, nil, nil)
+	cumulative := [][]float64{{1}, {1}}
+	if _, ok := j.pick(ctx, rand.New(rand.NewSource(1)), cumulative, make([]int, 2), make([]Hand, 2)); ok {
+		t.Error("Expected pick to give up once ctx is done")
+	}
+}
+
+func TestRangeEquity_WideRanges(t *testing.T) {
+	t.Parallel()
+
+	// Any two cards, for four players, has far too many deals to count.
+	var anyTwo Range
+	for a := Card(0); a < DeckSize; a++ {
+		for b := a + 1; b < DeckSize; b++ {
+			anyTwo.Set(NewCombo(a, b), 1)
+		}
+	}
+	ranges := []Range{anyTwo, anyTwo, anyTwo, anyTwo}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := RangeEquity(ctx, ranges, nil, nil, 10000, WithEquitySeed(1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if res.Exact || res.Runouts != 10000 {
+		t.Errorf("Expected 10000 samples, got: %d exact: %v", res.Runouts, res.Exact)
+	}
+	for i, p := range res.Players {
+		if math.Abs(p.Share-0.25) > 0.03 {
+			t.Errorf("Player %d: expected a share near 0.25, got: %v", i, p.Share)
+		}
+	}
+
+	if _, err := RangeEquity(ctx, ranges, nil, nil, 0); !errors.Is(err, ErrSamples) {
+		t.Errorf("Expected: %v, got: %v", ErrSamples, err)
+	}
+}
+
+func TestRangeEquity_Errors(t *testing.T) {
+	t.Parallel()
+
+	aa := MustParseRange("AA")
+	cases := []struct {
+		Ranges []Range
+		Board  []Card
+		Expect error
+	}{
+		{[]Range{aa}, nil, ErrTooFewPlayers},
+		{[]Range{aa, MustParseRange("AsAh")}, mustParseCards(t, "ad"), ErrEmptyRange},
+		{[]Range{aa, MustParseRange("AsAh")}, mustParseCards(t, "as"), ErrEmptyRange},
+		{[]Range{aa, {}}, nil, ErrEmptyRange},
+		{[]Range{aa, aa}, mustParseCards(t, "2c 3c 4c 5c 6c 7c"), ErrBoardSize},
+	}
+
+	for _, c := range cases {
+		if _, err := RangeEquity(context.Background(), c.Ranges, c.Board, nil, 100); !errors.Is(err, c.Expect) {
+			t.Errorf("%v %v: expected: %v, got: %v", c.Ranges, c.Board, c.Expect, err)
+		}
+	}
+}
+
+func equityClose(a, b PlayerEquity, tolerance float64) bool {
+	return math.Abs(a.Win-b.Win) <= tolerance && math.Abs(a.Tie-b.Tie) <= tolerance &&
+		math.Abs(a.Lose-b.Lose) <= tolerance && math.Abs(a.Share-b.Share) <= tolerance
+}
+
+func BenchmarkRangeEquity_Flop(b *testing.B) {
+	ranges := []Range{MustParseRange("TT+, AQs+"), MustParseRange("22+, A2s+, KTo+")}
+	board, _ := ParseCards("ah 7c 2s 9d")
+	for i := 0; i < b.N; i++ {
+		RangeEquity(context.Background(), ranges, board, nil, 0)
+	}
+}
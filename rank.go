@@ -0,0 +1,82 @@
+package holdem
+
+import "sort"
+
+// NumRanks is the number of distinct five card hands, once suits only
+// matter for flushes.
+const NumRanks = 7462
+
+// rankTable holds every distinct five card value, from the best to the worst.
+var rankTable = buildRankTable()
+
+// Rank returns the equivalence class of the hand as Cactus Kev numbers them:
+// 1 for a royal flush down to 7462 for 7-5-4-3-2 offsuit. It returns 0 for
+// values no five distinct cards can make, like five of a kind.
+func (h HandValue) Rank() int {
+	i := sort.Search(NumRanks, func(i int) bool { return rankTable[i] <= h })
+	if i == NumRanks || rankTable[i] != h {
+		return 0
+	}
+
+	return i + 1
+}
+
+// RankValue is the inverse of Rank. It returns false if rank is not between
+// 1 and 7462.
+func RankValue(rank int) (HandValue, bool) {
+	if rank < 1 || rank > NumRanks {
+		return 0, false
+	}
+
+	return rankTable[rank-1], true
+}
+
+// buildRankTable values one hand of every multiset of five ranks, and a
+// suited hand of every set of five different ranks.
+func buildRankTable() []HandValue {
+	seen := make(map[HandValue]bool, NumRanks)
+	var counts [13]int
+
+	var walk func(rank, left int)
+	walk = func(rank, left int) {
+		if left == 0 {
+			var offsuit, suited Hand
+			distinct := 0
+			i := 0
+			for r, n := range counts {
+				if n > 0 {
+					distinct++
+					suited |= 1 << NewCard(r+2, Spades)
+				}
+				for ; n > 0; n-- {
+					offsuit |= 1 << NewCard(r+2, i%4)
+					i++
+				}
+			}
+
+			seen[offsuit.Value()] = true
+			if distinct == 5 {
+				seen[suited.Value()] = true
+			}
+			return
+		}
+		if rank == 13 {
+			return
+		}
+
+		for n := 0; n <= left && n <= 4; n++ {
+			counts[rank] = n
+			walk(rank+1, left-n)
+		}
+		counts[rank] = 0
+	}
+	walk(0, 5)
+
+	table := make([]HandValue, 0, len(seen))
+	for v := range seen {
+		table = append(table, v)
+	}
+	sort.Slice(table, func(i, j int) bool { return table[i] > table[j] })
+
+	return table
+}
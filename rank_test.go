@@ -0,0 +1,114 @@
+package holdem
+
+import "testing"
+
+func TestHandValue_Rank(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Cards  string
+		Expect int
+	}{
+		{"as ks qs js 10s", 1},
+		{"5d 4d 3d 2d ad", 10},
+		{"ac ad ah as kc", 11},
+		{"2c 2d 2h 2s 3c", 166},
+		{"ac ad ah kc kd", 167},
+		{"ac kc qc jc 9c", 323},
+		{"7h 5h 4h 3h 2h", 1599},
+		{"ac kd qh js 10c", 1600},
+		{"5c 4d 3h 2s ac", 1609},
+		{"ac ad ah kc qd", 1610},
+		{"ac ad kh kc qd", 2468},
+		{"ac ad kh qc jd", 3326},
+		{"ac kd qh jc 9d", 6186},
+		{"7c 5d 4h 3c 2d", 7462},
+	}
+
+	for _, c := range cases {
+		v := NewHandStr(c.Cards).Value()
+		if got := v.Rank(); got != c.Expect {
+			t.Errorf("%s: expected: %d, got: %d", c.Cards, c.Expect, got)
+		}
+		if got, ok := RankValue(c.Expect); !ok || got != v {
+			t.Errorf("%d: expected: %v, got: %v", c.Expect, v, got)
+		}
+	}
+
+	if got := NewHandStr("7c 5d 4h 3c 2d 2s").Value().Rank(); got != 6179 {
+		t.Errorf("Expected a pair of twos to rank 6179, got: %d", got)
+	}
+	if _, ok := RankValue(0); ok {
+		t.Error("Expected rank 0 to be invalid")
+	}
+	if _, ok := RankValue(NumRanks + 1); ok {
+		t.Error("Expected rank 7463 to be invalid")
+	}
+	if got := HandValue(fiveOfAKindVal).Rank(); got != 0 {
+		t.Errorf("Expected: 0, got: %d", got)
+	}
+}
+
+func TestHandValue_RankClasses(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("Enumerates every five card hand")
+	}
+
+	// The last rank of each class, from the best class to the worst.
+	bounds := []struct {
+		Class HandClass
+		Last  int
+		Hands int
+	}{
+		{StraightFlush, 10, 40},
+		{FourOfAKind, 166, 624},
+		{FullHouse, 322, 3744},
+		{Flush, 1599, 5108},
+		{Straight, 1609, 10200},
+		{Trips, 2467, 54912},
+		{TwoPair, 3325, 123552},
+		{Pair, 6185, 1098240},
+		{HighCard, 7462, 1302540},
+	}
+
+	var seen [NumRanks + 1]bool
+	hands := make(map[HandClass]int)
+	var hand Hand
+	for a := 0; a < 52; a++ {
+		for b := a + 1; b < 52; b++ {
+			for c := b + 1; c < 52; c++ {
+				for d := c + 1; d < 52; d++ {
+					for e := d + 1; e < 52; e++ {
+						hand = Hand(cardMasksTable[a] | cardMasksTable[b] | cardMasksTable[c] | cardMasksTable[d] | cardMasksTable[e])
+						val := hand.ValueCards(5)
+						rank := val.Rank()
+
+						i := 0
+						for rank > bounds[i].Last {
+							i++
+						}
+						if rank == 0 || bounds[i].Class != val.Class() {
+							t.Fatalf("%v: %v has rank %d", hand.Cards(), val, rank)
+						}
+
+						seen[rank] = true
+						hands[val.Class()]++
+					}
+				}
+			}
+		}
+	}
+
+	for _, b := range bounds {
+		if got := hands[b.Class]; got != b.Hands {
+			t.Errorf("%v: expected: %d hands, got: %d", b.Class, b.Hands, got)
+		}
+	}
+	for rank := 1; rank <= NumRanks; rank++ {
+		if !seen[rank] {
+			t.Errorf("No hand has rank %d", rank)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package holdem
+
+// ShortDeckSize is the number of cards in a short deck, which has no twos,
+// threes, fours or fives.
+const ShortDeckSize = 36
+
+// shortOrderShift places the short deck order of a class above the class
+// itself, so short deck values compare by that order first.
+const shortOrderShift = 28
+
+// ShortDeckValue computes the value of the hand under short deck rules:
+// A-6-7-8-9 is the lowest straight, a flush beats a full house, and trips
+// beat a straight if tripsBeatStraight is set, or lose to it otherwise.
+// Class and String work as usual, but the value only compares with other
+// short deck values under the same rules.
+func (h Hand) ShortDeckValue(tripsBeatStraight bool) HandValue {
+	nCards := countBits(uint64(h))
+	v := h.valueCards(nCards, shortStraightTable)
+
+	// A straight hides trips when both are made; the trips only matter
+	// when they rank higher.
+	if tripsBeatStraight && v.Class() == Straight {
+		if t := h.valueCards(nCards, noStraightTable); t.Class() == Trips {
+			v = t
+		}
+	}
+
+	return shortDeckOrdered(v, tripsBeatStraight)
+}
+
+// ShortDeckValue is like Hand.ShortDeckValue for hands that may hold the
+// same card more than once, as dealt from several short decks.
+func (m MultiHand) ShortDeckValue(tripsBeatStraight bool) HandValue {
+	v, flush := m.value(shortStraightTable)
+
+	// Duplicate cards can make a full house and a flush at once.
+	switch {
+	case v.Class() == FullHouse && flush != 0:
+		v = flush
+	case tripsBeatStraight && v.Class() == Straight:
+		if t, _ := m.value(noStraightTable); t.Class() == Trips {
+			v = t
+		}
+	}
+
+	return shortDeckOrdered(v, tripsBeatStraight)
+}
+
+// shortDeckOrdered puts the short deck order of the class of v above it.
+func shortDeckOrdered(v HandValue, tripsBeatStraight bool) HandValue {
+	order := shortDeckOrder
+	if tripsBeatStraight {
+		order = shortDeckTripsOrder
+	}
+
+	return HandValue(order[v.Class()])<<shortOrderShift | v
+}
+
+// shortDeckOrder ranks the classes from worst to best when a straight beats
+// trips, and shortDeckTripsOrder when trips beat a straight.
+var (
+	shortDeckOrder = [...]uint32{
+		HighCard: 1, Pair: 2, TwoPair: 3, Trips: 4, Straight: 5,
+		FullHouse: 6, Flush: 7, FourOfAKind: 8, StraightFlush: 9, FiveOfAKind: 10,
+	}
+	shortDeckTripsOrder = [...]uint32{
+		HighCard: 1, Pair: 2, TwoPair: 3, Straight: 4, Trips: 5,
+		FullHouse: 6, Flush: 7, FourOfAKind: 8, StraightFlush: 9, FiveOfAKind: 10,
+	}
+)
+
+// noStraightTable finds no straights at all.
+var noStraightTable = make([]uint16, len(shortStraightTable))
+
+// ShortDeckEvaluator returns an Evaluator for short deck hold'em, with trips
+// beating a straight if tripsBeatStraight is set.
+func ShortDeckEvaluator(tripsBeatStraight bool) Evaluator {
+	return func(hole, board []Card) HandValue {
+		return NewHand(hole, board).ShortDeckValue(tripsBeatStraight)
+	}
+}
+
+// ShortDeckMultiEvaluator is like ShortDeckEvaluator for games with several
+// short decks, where cards may be duplicated.
+func ShortDeckMultiEvaluator(tripsBeatStraight bool) Evaluator {
+	return func(hole, board []Card) HandValue {
+		return NewMultiHand(hole, board).ShortDeckValue(tripsBeatStraight)
+	}
+}
+
+// WithShortDeck makes the game deal from short decks, and score hands with
+// ShortDeckEvaluator(tripsBeatStraight), or ShortDeckMultiEvaluator with
+// several decks.
+func WithShortDeck(tripsBeatStraight bool) Option {
+	return func(g *Game) {
+		g.short = true
+		g.evaluate = nil
+		g.variant = func(multi bool) Evaluator {
+			if multi {
+				return ShortDeckMultiEvaluator(tripsBeatStraight)
+			}
+			return ShortDeckEvaluator(tripsBeatStraight)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package holdem
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestHand_ShortDeckValue(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Cards  string
+		Trips  bool
+		Class  HandClass
+		String string
+	}{
+		{"as 6d 7h 8c 9s", false, Straight, "Straight with 9 high"},
+		{"as 6s 7s 8s 9s", false, StraightFlush, "Straight flush with 9 high"},
+		{"6c 7d 8h 9s 10c 10d 10h", false, Straight, "Straight with 10 high"},
+		{"6c 7d 8h 9s 10c 10d 10h", true, Trips, "Three of a kind: 10's"},
+		{"6c 7d 8h 9s 10c 10d jh", true, Straight, "Straight with J high"},
+		{"ac 6d 7h 8s 9c 9d 9h", true, Trips, "Three of a kind: 9's"},
+	}
+
+	for _, c := range cases {
+		v := NewHandStr(c.Cards).ShortDeckValue(c.Trips)
+		if v.Class() != c.Class || v.String() != c.String {
+			t.Errorf("%s: expected: %v, got: %v", c.Cards, c.String, v)
+		}
+	}
+
+	// Each hand beats the one after it, when a straight beats trips and when
+	// trips beat a straight.
+	orders := map[bool][]string{
+		false: {
+			"10s js qs ks as",
+			"as 6s 7s 8s 9s",
+			"ac ad ah as kc",
+			"ac kc 9c 8c 6c",
+			"ac ad ah kc kd",
+			"10s jd qh kc as",
+			"as 6d 7h 8c 9s",
+			"ac ad ah kc qd",
+			"ac ad kh kc qd",
+		},
+		true: {
+			"ac kc 9c 8c 6c",
+			"ac ad ah kc kd",
+			"6c 6d 6h 7c 8d",
+			"10s jd qh kc as",
+			"as 6d 7h 8c 9s",
+			"ac ad kh kc qd",
+		},
+	}
+	for trips, order := range orders {
+		for i := 1; i < len(order); i++ {
+			a := NewHandStr(order[i-1]).ShortDeckValue(trips)
+			b := NewHandStr(order[i]).ShortDeckValue(trips)
+			if a <= b {
+				t.Errorf("Trips beat straight %v: expected %s (%v) to beat %s (%v)", trips, order[i-1], a, order[i], b)
+			}
+		}
+	}
+}
+
+func TestHand_ShortDeckSevenCards(t *testing.T) {
+	t.Parallel()
+
+	// Seven card values must be the best of their five card subsets.
+	deck := NewDeck(rand.NewSource(21))
+	deck.SetShortDeck(true)
+	for n := 0; n < 20000; n++ {
+		trips := n%2 == 0
+		deck.Reset()
+		deck.Shuffle()
+		cards := deck.Deal(7)
+
+		var best HandValue
+		eachFive(cards, func(five []Card) {
+			if v := NewHandCards(five).ShortDeckValue(trips); v > best {
+				best = v
+			}
+		})
+
+		if got := NewHandCards(cards).ShortDeckValue(trips); got != best {
+			t.Fatalf("%v: expected: %v, got: %v", cards, best, got)
+		}
+	}
+}
+
+func TestMultiHand_ShortDeckValue(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Cards string
+		Trips bool
+		Class HandClass
+	}{
+		{"as ks as 9d 8c 7h jd", false, Pair},             // Two aces of spades make a pair
+		{"ah ah kh kh kd 9h 6c", false, Flush},            // A flush beats the full house
+		{"6c 7d 8h 9s 10c 10c 10h", true, Trips},          // Trips beat the straight
+		{"6c 7d 8h 9s 10c 10c 10h", false, Straight},      // Or lose to it
+		{"as 6d 7h 8c 9s 9s 9d", false, Straight},         // A-6-7-8-9 is a straight
+		{"as as as as as kd qc", false, FiveOfAKind},      // Five copies of one card
+		{"ah kh qh jh 10h 10h 10d", false, StraightFlush}, // Above the full house and flush
+		{"jc jc jd 9s 9s 7h 6c", true, FullHouse},         // Trips and a pair
+		{"jc jc jd jd 9s 9s 9h", false, FourOfAKind},      // Quads beat the full house
+		{"kc kd 8s 8s 7h 6c ac", true, TwoPair},           // Identical eights still pair
+	}
+
+	for _, c := range cases {
+		if got := newMultiHandStr(t, c.Cards).ShortDeckValue(c.Trips); got.Class() != c.Class {
+			t.Errorf("%s: expected: %v, got: %v", c.Cards, c.Class, got)
+		}
+	}
+
+	// Without duplicates it scores like Hand.ShortDeckValue.
+	deck := NewDeck(rand.NewSource(21))
+	deck.SetShortDeck(true)
+	for n := 0; n < 20000; n++ {
+		trips := n%2 == 0
+		deck.Reset()
+		deck.Shuffle()
+		cards := deck.Deal(7)
+
+		if exp, got := NewHandCards(cards).ShortDeckValue(trips), NewMultiHand(cards).ShortDeckValue(trips); got != exp {
+			t.Fatalf("%v: expected: %v, got: %v", cards, exp, got)
+		}
+	}
+}
+
+func TestDeck_ShortDeck(t *testing.T) {
+	t.Parallel()
+
+	deck := NewDeck(rand.NewSource(1))
+	deck.SetShortDeck(true)
+	if got := deck.Remaining(); got != ShortDeckSize {
+		t.Errorf("Expected: %d, got: %d", ShortDeckSize, got)
+	}
+
+	deck.SetDecks(2)
+	deck.Shuffle()
+	if got := deck.Remaining(); got != 2*ShortDeckSize {
+		t.Errorf("Expected: %d, got: %d", 2*ShortDeckSize, got)
+	}
+	for _, c := range deck.Deal(deck.Remaining()) {
+		if c.Value() < 4 {
+			t.Errorf("Dealt %v from a short deck", c)
+		}
+	}
+}
+
+func TestGame_ShortDeckDecks(t *testing.T) {
+	t.Parallel()
+
+	// With two decks, the same card twice is a pair.
+	game := New(WithShortDeck(false), WithDecks(2))
+	hole := []Card{NewCardStr("as"), NewCardStr("ks")}
+	board := []Card{NewCardStr("as"), NewCardStr("9d"), NewCardStr("8c"), NewCardStr("7h"), NewCardStr("jd")}
+	if got := game.evaluate(hole, board); got.Class() != Pair {
+		t.Errorf("Expected: %v, got: %v", Pair, got)
+	}
+}
+
+func TestGame_ShortDeck(t *testing.T) {
+	t.Parallel()
+
+	game := New(WithShortDeck(true), WithProvablyFair())
+	for _, name := range []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O"} {
+		if err := game.AddPlayer(name); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if err := game.AddPlayer("P"); err == nil {
+		t.Error("Expected the 16th player not to fit")
+	}
+
+	game.Play()
+
+	var dealt []Card
+	for _, p := range game.seatOrder() {
+		dealt = append(dealt, p.Hand...)
+	}
+	dealt = append(dealt, game.community...)
+	for _, c := range dealt {
+		if c.Value() < 4 {
+			t.Errorf("Dealt %v in a short deck game", c)
+		}
+	}
+
+	if err := Verify(game.Commitment(), game.Reveal(), dealt); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package holdem
+
+// BetState describes the betting round to a BettingStructure, from the
+// point of view of the player to act.
+type BetState struct {
+	Street     RoundStatus
+	CurrentBet uint32 // Highest bet this street
+	LastRaise  uint32 // Size of the last full bet or raise, at least the big blind
+	Raises     int    // Full bets and raises this street, counting the big blind
+	Pot        uint32 // Every chip put in this hand, including this street's bets
+	Bet        uint32 // The player's own bet this street
+	BigBlind   uint32
+}
+
+// BettingStructure decides how much the player to act may bet or raise.
+type BettingStructure interface {
+	// RaiseLimits returns the smallest and largest totals the player may
+	// bet or raise to, or ok false if the betting is capped. The game
+	// lowers both to the player's stack when they cannot cover them.
+	RaiseLimits(s BetState) (min, max uint32, ok bool)
+}
+
+// NoLimit lets players bet any amount up to their whole stack. A raise must
+// be at least as big as the last full bet or raise.
+type NoLimit struct{}
+
+// RaiseLimits implements BettingStructure.
+func (NoLimit) RaiseLimits(s BetState) (uint32, uint32, bool) {
+	return s.CurrentBet + s.LastRaise, ^uint32(0), true
+}
+
+// PotLimit lets players raise by at most the size of the pot after calling.
+type PotLimit struct{}
+
+// RaiseLimits implements BettingStructure.
+func (PotLimit) RaiseLimits(s BetState) (uint32, uint32, bool) {
+	call := s.CurrentBet - s.Bet
+
+	return s.CurrentBet + s.LastRaise, s.CurrentBet + s.Pot + call, true
+}
+
+// FixedLimit allows bets and raises of one fixed size: Small before the
+// flop and on the flop, Big on the turn and the river. At most Cap bets and
+// raises are allowed each street; a Cap of zero means no cap.
+type FixedLimit struct {
+	Small uint32
+	Big   uint32
+	Cap   int
+}
+
+// RaiseLimits implements BettingStructure.
+func (f FixedLimit) RaiseLimits(s BetState) (uint32, uint32, bool) {
+	if f.Cap > 0 && s.Raises >= f.Cap {
+		return 0, 0, false
+	}
+
+	size := f.Small
+	if s.Street == Turn || s.Street == River {
+		size = f.Big
+	}
+
+	return s.CurrentBet + size, s.CurrentBet + size, true
+}
+
+// WithBettingStructure sets how much players may bet. Games are no-limit
+// unless told otherwise.
+func WithBettingStructure(s BettingStructure) Option {
+	return func(g *Game) {
+		g.structure = s
+	}
+}
+
+func (g *Game) betState(p *Player) BetState {
+	return BetState{
+		Street:     g.street,
+		CurrentBet: g.currentBet,
+		LastRaise:  g.minRaise,
+		Raises:     g.raises,
+		Pot:        g.Pot(),
+		Bet:        p.Bet,
+		BigBlind:   g.bigBlind,
+	}
+}
@@ -0,0 +1,89 @@
+package holdem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoLimit_MinRaise(t *testing.T) {
+	game := newBlindsGame([]Option{WithBlinds(1, 2)}, "A", "B", "C")
+
+	assert.NoError(t, game.Act("A", Action{Raise, 10}))
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 10, 10}, {Raise, 18, 100}}, game.LegalActions("B"))
+}
+
+func TestNoLimit_IncompleteRaise(t *testing.T) {
+	game := newBettingGame("A", "B", "C")
+	game.players[0].Balance = 500
+	game.players[1].Balance = 120
+	game.players[2].Balance = 500
+
+	assert.NoError(t, game.Act("A", Action{Bet, 100}))
+	assert.NoError(t, game.Act("B", Action{Raise, 120}))
+
+	// C has not acted yet, and may raise by the last full raise.
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 120, 120}, {Raise, 220, 500}}, game.LegalActions("C"))
+	assert.NoError(t, game.Call("C"))
+
+	// The short all in does not reopen the betting for A.
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 120, 120}}, game.LegalActions("A"))
+	assert.Error(t, game.Act("A", Action{Raise, 240}))
+	assert.NoError(t, game.Call("A"))
+	assert.Equal(t, "", game.ToAct())
+}
+
+func TestNoLimit_FullRaiseReopens(t *testing.T) {
+	game := newBettingGame("A", "B", "C")
+	game.players[0].Balance = 500
+	game.players[1].Balance = 120
+	game.players[2].Balance = 500
+
+	assert.NoError(t, game.Act("A", Action{Bet, 100}))
+	assert.NoError(t, game.Act("B", Action{Raise, 120}))
+	assert.NoError(t, game.Act("C", Action{Raise, 250}))
+
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 250, 250}, {Raise, 380, 500}}, game.LegalActions("A"))
+}
+
+func TestPotLimit(t *testing.T) {
+	game := newBlindsGame([]Option{WithBlinds(1, 2), WithBettingStructure(PotLimit{})}, "A", "B", "C")
+
+	// Calling 2 makes the pot 5, so A may raise by 5 to 7.
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 2, 2}, {Raise, 4, 7}}, game.LegalActions("A"))
+	assert.NoError(t, game.Act("A", Action{Raise, 7}))
+
+	// B calls 6 more into a pot of 10, then may raise by 16.
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 7, 7}, {Raise, 12, 23}}, game.LegalActions("B"))
+	assert.Error(t, game.Act("B", Action{Raise, 24}))
+
+	// An opening bet after the flop is limited to the pot.
+	game.finishRound()
+	newHand(game)
+	game.startBets(game.next(game.button))
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Type: Check}, {Bet, 2, 3}}, game.LegalActions(game.ToAct()))
+}
+
+func TestFixedLimit(t *testing.T) {
+	fl := FixedLimit{Small: 2, Big: 4, Cap: 4}
+	game := newBlindsGame([]Option{WithBlinds(1, 2), WithBettingStructure(fl)}, "A", "B", "C")
+
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 2, 2}, {Raise, 4, 4}}, game.LegalActions("A"))
+	assert.Error(t, game.Act("A", Action{Raise, 6}))
+	assert.NoError(t, game.Act("A", Action{Raise, 4}))
+	assert.NoError(t, game.Act("B", Action{Raise, 6}))
+	assert.NoError(t, game.Act("C", Action{Raise, 8}))
+
+	// Capped at four bets.
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Call, 8, 8}}, game.LegalActions("A"))
+	assert.NoError(t, game.Call("A"))
+	assert.NoError(t, game.Call("B"))
+
+	game.dealFlop()
+	game.startBets(game.next(game.button))
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Type: Check}, {Bet, 2, 2}}, game.LegalActions("B"))
+
+	game.dealTurn()
+	game.startBets(game.next(game.button))
+	assert.Equal(t, []LegalAction{{Type: Fold}, {Type: Check}, {Bet, 4, 4}}, game.LegalActions("B"))
+}